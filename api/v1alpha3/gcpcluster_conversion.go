@@ -24,25 +24,48 @@ import (
 	apiv1alpha4 "sigs.k8s.io/cluster-api/api/v1alpha4"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 
-	v1alpha4 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 )
 
-// ConvertTo converts this GCPCluster to the Hub version (v1alpha4).
+// ConvertTo converts this GCPCluster to the Hub version (v1beta1).
 func (src *GCPCluster) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*v1alpha4.GCPCluster)
+	dst := dstRaw.(*infrav1.GCPCluster)
 
-	if err := Convert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(src, dst, nil); err != nil {
+	if err := Convert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(src, dst, nil); err != nil {
 		return err
 	}
 
+	// Restore the fields that don't exist in v1alpha3, including the recorded names
+	// of the GCP resources, so that they're never orphaned.
+	restored := &infrav1.GCPCluster{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.ResourceNamePrefix = restored.Spec.ResourceNamePrefix
+	dst.Spec.NetworkTier = restored.Spec.NetworkTier
+	dst.Spec.LoadBalancer = restored.Spec.LoadBalancer
+	dst.Spec.ControlPlaneAccess = restored.Spec.ControlPlaneAccess
+	dst.Spec.PlacementPolicies = restored.Spec.PlacementPolicies
+	dst.Spec.Bastion = restored.Spec.Bastion
+	dst.Spec.DNS = restored.Spec.DNS
+	dst.Spec.Network.APIServerStackType = restored.Spec.Network.APIServerStackType
+	restoreSubnets(restored.Spec.Network.Subnets, dst.Spec.Network.Subnets)
+
+	dst.Status.Bastion = restored.Status.Bastion
+	dst.Status.Network.APIServerIP = restored.Status.Network.APIServerIP
+	dst.Status.Network.APIServerIPv6Address = restored.Status.Network.APIServerIPv6Address
+	dst.Status.Network.APIServerIPv6 = restored.Status.Network.APIServerIPv6
+	dst.Status.Network.APIServerIPv6ForwardingRule = restored.Status.Network.APIServerIPv6ForwardingRule
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*v1alpha4.GCPCluster)
+	src := srcRaw.(*infrav1.GCPCluster)
 
-	if err := Convert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(src, dst, nil); err != nil {
+	if err := Convert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -54,78 +77,62 @@ func (dst *GCPCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	return nil
 }
 
-// ConvertTo converts this GCPClusterList to the Hub version (v1alpha4).
+// ConvertTo converts this GCPClusterList to the Hub version (v1beta1).
 func (src *GCPClusterList) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*v1alpha4.GCPClusterList)
-	return Convert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(src, dst, nil)
+	dst := dstRaw.(*infrav1.GCPClusterList)
+	return Convert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPClusterList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*v1alpha4.GCPClusterList)
-	return Convert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(src, dst, nil)
+	src := srcRaw.(*infrav1.GCPClusterList)
+	return Convert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList(src, dst, nil)
 }
 
-// Convert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus converts GCPCluster.Status from v1alpha3 to v1alpha4.
-func Convert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in *GCPClusterStatus, out *v1alpha4.GCPClusterStatus, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in, out, s); err != nil {
+// Convert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus converts GCPCluster.Status from v1alpha3 to infrav1.
+func Convert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in *GCPClusterStatus, out *infrav1.GCPClusterStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec.
-func Convert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in *GCPClusterSpec, out *v1alpha4.GCPClusterSpec, s apiconversion.Scope) error { //nolint
-	if err := autoConvert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in, out, s); err != nil {
+// Convert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec.
+func Convert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in *GCPClusterSpec, out *infrav1.GCPClusterSpec, s apiconversion.Scope) error { //nolint
+	if err := autoConvert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec converts from the Hub version (v1alpha4) of the GCPClusterSpec to this version.
-func Convert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in *v1alpha4.GCPClusterSpec, out *GCPClusterSpec, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in, out, s); err != nil {
+// Convert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec converts from the Hub version (v1beta1) of the GCPClusterSpec to this version.
+func Convert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in *infrav1.GCPClusterSpec, out *GCPClusterSpec, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus.
-func Convert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in *v1alpha4.GCPClusterStatus, out *GCPClusterStatus, s apiconversion.Scope) error { //nolint
-	if err := autoConvert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in, out, s); err != nil {
+// Convert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus.
+func Convert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in *infrav1.GCPClusterStatus, out *GCPClusterStatus, s apiconversion.Scope) error { //nolint
+	if err := autoConvert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha3_NetworkSpec_To_v1alpha4_NetworkSpec.
-func Convert_v1alpha3_NetworkSpec_To_v1alpha4_NetworkSpec(in *NetworkSpec, out *v1alpha4.NetworkSpec, s apiconversion.Scope) error { //nolint
-	out.Subnets = make(v1alpha4.Subnets, len(in.Subnets))
-	for i := range in.Subnets {
-		out.Subnets[i] = &v1alpha4.SubnetSpec{}
-		if err := Convert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(in.Subnets[i], out.Subnets[i], s); err != nil {
-			return err
-		}
-	}
-
-	return nil
+// Convert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec.
+func Convert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec(in *NetworkSpec, out *infrav1.NetworkSpec, s apiconversion.Scope) error { //nolint
+	return autoConvert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec(in, out, s)
 }
 
-// Convert_v1alpha4_NetworkSpec_To_v1alpha3_NetworkSpec.
-func Convert_v1alpha4_NetworkSpec_To_v1alpha3_NetworkSpec(in *v1alpha4.NetworkSpec, out *NetworkSpec, s apiconversion.Scope) error { //nolint
-	out.Subnets = make(Subnets, len(in.Subnets))
-	for i := range in.Subnets {
-		out.Subnets[i] = &SubnetSpec{}
-		if err := Convert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(in.Subnets[i], out.Subnets[i], s); err != nil {
-			return err
-		}
-	}
-
-	return nil
+// Convert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec.
+func Convert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec(in *infrav1.NetworkSpec, out *NetworkSpec, s apiconversion.Scope) error { //nolint
+	return autoConvert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec(in, out, s)
 }
 
 // Convert_v1alpha3_APIEndpoint_To_v1alpha4_APIEndpoint is an autogenerated conversion function.
@@ -137,3 +144,52 @@ func Convert_v1alpha3_APIEndpoint_To_v1alpha4_APIEndpoint(in *apiv1alpha3.APIEnd
 func Convert_v1alpha4_APIEndpoint_To_v1alpha3_APIEndpoint(in *apiv1alpha4.APIEndpoint, out *apiv1alpha3.APIEndpoint, s apiconversion.Scope) error {
 	return apiv1alpha3.Convert_v1alpha4_APIEndpoint_To_v1alpha3_APIEndpoint(in, out, s)
 }
+
+// Convert_v1beta1_Network_To_v1alpha3_Network converts from the Hub version (v1beta1) of the Network to this version.
+func Convert_v1beta1_Network_To_v1alpha3_Network(in *infrav1.Network, out *Network, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_Network_To_v1alpha3_Network(in, out, s)
+}
+
+// Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec converts from the Hub version (v1beta1) of the SubnetSpec to this version.
+func Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in, out, s)
+}
+
+// Convert_Pointer_v1alpha3_SubnetSpec_To_Pointer_v1beta1_SubnetSpec converts the pointers to SubnetSpec of Subnets.
+func Convert_Pointer_v1alpha3_SubnetSpec_To_Pointer_v1beta1_SubnetSpec(in **SubnetSpec, out **infrav1.SubnetSpec, s apiconversion.Scope) error { // nolint
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+
+	*out = &infrav1.SubnetSpec{}
+	return Convert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec(*in, *out, s)
+}
+
+// Convert_Pointer_v1beta1_SubnetSpec_To_Pointer_v1alpha3_SubnetSpec converts the pointers to SubnetSpec of Subnets.
+func Convert_Pointer_v1beta1_SubnetSpec_To_Pointer_v1alpha3_SubnetSpec(in **infrav1.SubnetSpec, out **SubnetSpec, s apiconversion.Scope) error { // nolint
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+
+	*out = &SubnetSpec{}
+	return Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(*in, *out, s)
+}
+
+// restoreSubnets restores the fields of the subnets that don't exist in v1alpha3.
+func restoreSubnets(restored, dst infrav1.Subnets) {
+	// Subnets added or removed in v1alpha3 can't be matched with the restored ones.
+	if len(dst) != len(restored) {
+		return
+	}
+
+	for i := range dst {
+		if dst[i] == nil || restored[i] == nil {
+			continue
+		}
+
+		dst[i].StackType = restored[i].StackType
+		dst[i].IPv6AccessType = restored[i].IPv6AccessType
+	}
+}
@@ -21,24 +21,39 @@ import (
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-	v1alpha4 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 )
 
-// ConvertTo converts this GCPMachine to the Hub version (v1alpha4).
+// ConvertTo converts this GCPMachine to the Hub version (v1beta1).
 func (src *GCPMachine) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*v1alpha4.GCPMachine)
+	dst := dstRaw.(*infrav1.GCPMachine)
 
-	if err := Convert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(src, dst, nil); err != nil {
+	if err := Convert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(src, dst, nil); err != nil {
 		return err
 	}
 
+	// Restore the fields that don't exist in v1alpha3, including the recorded name
+	// of the instance, so that it's never orphaned.
+	restored := &infrav1.GCPMachine{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreGCPMachineSpec(&restored.Spec, &dst.Spec)
+	dst.Status.InstanceName = restored.Status.InstanceName
+	dst.Status.Image = restored.Status.Image
+	dst.Status.BootstrapDataSecret = restored.Status.BootstrapDataSecret
+	dst.Status.BootstrapDataObject = restored.Status.BootstrapDataObject
+	dst.Status.ReservedInternalAddress = restored.Status.ReservedInternalAddress
+	dst.Status.ReservedExternalAddress = restored.Status.ReservedExternalAddress
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPMachine) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*v1alpha4.GCPMachine)
-	if err := Convert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(src, dst, nil); err != nil {
+	src := srcRaw.(*infrav1.GCPMachine)
+	if err := Convert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -50,49 +65,91 @@ func (dst *GCPMachine) ConvertFrom(srcRaw conversion.Hub) error { // nolint
 	return nil
 }
 
-// ConvertTo converts this GCPMachineList to the Hub version (v1alpha4).
+// ConvertTo converts this GCPMachineList to the Hub version (v1beta1).
 func (src *GCPMachineList) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*v1alpha4.GCPMachineList)
-	return Convert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(src, dst, nil)
+	dst := dstRaw.(*infrav1.GCPMachineList)
+	return Convert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPMachineList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*v1alpha4.GCPMachineList)
-	return Convert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(src, dst, nil)
+	src := srcRaw.(*infrav1.GCPMachineList)
+	return Convert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList(src, dst, nil)
 }
 
-func Convert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in *GCPMachineSpec, out *v1alpha4.GCPMachineSpec, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in, out, s); err != nil {
+func Convert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in *GCPMachineSpec, out *infrav1.GCPMachineSpec, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec converts from the Hub version (v1alpha4) of the GCPMachineSpec to this version.
-func Convert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in *v1alpha4.GCPMachineSpec, out *GCPMachineSpec, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in, out, s); err != nil {
+// Convert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec converts from the Hub version (v1beta1) of the GCPMachineSpec to this version.
+func Convert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in *infrav1.GCPMachineSpec, out *GCPMachineSpec, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus converts this GCPMachineStatus to the Hub version (v1alpha4).
-func Convert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in *GCPMachineStatus, out *v1alpha4.GCPMachineStatus, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in, out, s); err != nil {
+// Convert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus converts this GCPMachineStatus to the Hub version (v1beta1).
+func Convert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in *GCPMachineStatus, out *infrav1.GCPMachineStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus converts from the Hub version (v1alpha4) of the GCPMachineStatus to this version.
-func Convert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in *v1alpha4.GCPMachineStatus, out *GCPMachineStatus, s apiconversion.Scope) error { // nolint
-	if err := autoConvert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in, out, s); err != nil {
+// Convert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus converts from the Hub version (v1beta1) of the GCPMachineStatus to this version.
+func Convert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in *infrav1.GCPMachineStatus, out *GCPMachineStatus, s apiconversion.Scope) error { // nolint
+	if err := autoConvert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in, out, s); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Convert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec converts from the Hub version (v1beta1) of the AttachedDiskSpec to this version.
+func Convert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in *infrav1.AttachedDiskSpec, out *AttachedDiskSpec, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in, out, s)
+}
+
+// restoreGCPMachineSpec restores the fields of a GCPMachineSpec that don't exist in v1alpha3.
+func restoreGCPMachineSpec(restored, dst *infrav1.GCPMachineSpec) {
+	dst.CustomMachineType = restored.CustomMachineType
+	dst.MinCPUPlatform = restored.MinCPUPlatform
+	dst.AdvancedMachineFeatures = restored.AdvancedMachineFeatures
+	dst.GuestAccelerators = restored.GuestAccelerators
+	dst.NetworkInterfaces = restored.NetworkInterfaces
+	dst.NetworkTier = restored.NetworkTier
+	dst.AddressReservations = restored.AddressReservations
+	dst.FailureDomain = restored.FailureDomain
+	dst.FailureDomainFallback = restored.FailureDomainFallback
+	dst.ImageLookup = restored.ImageLookup
+	dst.ImageFamilyTemplate = restored.ImageFamilyTemplate
+	dst.NodeAffinities = restored.NodeAffinities
+	dst.PlacementPolicy = restored.PlacementPolicy
+	dst.ReservationAffinity = restored.ReservationAffinity
+	dst.BootstrapDataStorage = restored.BootstrapDataStorage
+	dst.BootstrapDataBucket = restored.BootstrapDataBucket
+
+	// Disks added or removed in v1alpha3 can't be matched with the restored ones.
+	if len(dst.AdditionalDisks) != len(restored.AdditionalDisks) {
+		return
+	}
+
+	for i := range dst.AdditionalDisks {
+		disk, restoredDisk := &dst.AdditionalDisks[i], &restored.AdditionalDisks[i]
+		disk.Name = restoredDisk.Name
+		disk.ProvisionedIOPS = restoredDisk.ProvisionedIOPS
+		disk.SourceSnapshot = restoredDisk.SourceSnapshot
+		disk.SourceImage = restoredDisk.SourceImage
+		disk.Labels = restoredDisk.Labels
+		disk.AutoDelete = restoredDisk.AutoDelete
+		disk.ResourcePolicies = restoredDisk.ResourcePolicies
+		disk.Count = restoredDisk.Count
+	}
+}
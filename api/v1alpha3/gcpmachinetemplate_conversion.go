@@ -17,26 +17,37 @@ limitations under the License.
 package v1alpha3
 
 import (
-	infrav1alpha4 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 )
 
-// ConvertTo converts this GCPMachineTemplate to the Hub version (v1alpha4).
+// ConvertTo converts this GCPMachineTemplate to the Hub version (v1beta1).
 func (src *GCPMachineTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*infrav1alpha4.GCPMachineTemplate)
+	dst := dstRaw.(*infrav1.GCPMachineTemplate)
 
-	if err := Convert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(src, dst, nil); err != nil {
+	if err := Convert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
+	// Restore the fields that don't exist in v1alpha3.
+	restored := &infrav1.GCPMachineTemplate{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Status = restored.Status
+	restoreGCPMachineSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
+
 	return nil
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*infrav1alpha4.GCPMachineTemplate)
-	if err := Convert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(src, dst, nil); err != nil {
+	src := srcRaw.(*infrav1.GCPMachineTemplate)
+	if err := Convert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
 
@@ -48,14 +59,24 @@ func (dst *GCPMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nol
 	return nil
 }
 
-// ConvertTo converts this GCPMachineTemplateList to the Hub version (v1alpha4).
+// ConvertTo converts this GCPMachineTemplateList to the Hub version (v1beta1).
 func (src *GCPMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error { // nolint
-	dst := dstRaw.(*infrav1alpha4.GCPMachineTemplateList)
-	return Convert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(src, dst, nil)
+	dst := dstRaw.(*infrav1.GCPMachineTemplateList)
+	return Convert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(src, dst, nil)
 }
 
-// ConvertFrom converts from the Hub version (v1alpha4) to this version.
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
 func (dst *GCPMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
-	src := srcRaw.(*infrav1alpha4.GCPMachineTemplateList)
-	return Convert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(src, dst, nil)
+	src := srcRaw.(*infrav1.GCPMachineTemplateList)
+	return Convert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(src, dst, nil)
+}
+
+// Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource converts from the Hub version (v1beta1) of the GCPMachineTemplateResource to this version.
+func Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in *infrav1.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in, out, s)
+}
+
+// Convert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate converts from the Hub version (v1beta1) of the GCPMachineTemplate to this version.
+func Convert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in *infrav1.GCPMachineTemplate, out *GCPMachineTemplate, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in, out, s)
 }
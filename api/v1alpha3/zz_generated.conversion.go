@@ -26,9 +26,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	v1alpha4 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
-	apiv1alpha4 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	v1alpha4 "sigs.k8s.io/cluster-api/api/v1alpha4"
 	errors "sigs.k8s.io/cluster-api/errors"
 )
 
@@ -39,256 +39,269 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
-	if err := s.AddGeneratedConversionFunc((*AttachedDiskSpec)(nil), (*v1alpha4.AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(a.(*AttachedDiskSpec), b.(*v1alpha4.AttachedDiskSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*AttachedDiskSpec)(nil), (*v1beta1.AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(a.(*AttachedDiskSpec), b.(*v1beta1.AttachedDiskSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.AttachedDiskSpec)(nil), (*AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(a.(*v1alpha4.AttachedDiskSpec), b.(*AttachedDiskSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*BuildParams)(nil), (*v1beta1.BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_BuildParams_To_v1beta1_BuildParams(a.(*BuildParams), b.(*v1beta1.BuildParams), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*BuildParams)(nil), (*v1alpha4.BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_BuildParams_To_v1alpha4_BuildParams(a.(*BuildParams), b.(*v1alpha4.BuildParams), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.BuildParams)(nil), (*BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BuildParams_To_v1alpha3_BuildParams(a.(*v1beta1.BuildParams), b.(*BuildParams), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.BuildParams)(nil), (*BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_BuildParams_To_v1alpha3_BuildParams(a.(*v1alpha4.BuildParams), b.(*BuildParams), scope)
+	if err := s.AddGeneratedConversionFunc((*Filter)(nil), (*v1beta1.Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Filter_To_v1beta1_Filter(a.(*Filter), b.(*v1beta1.Filter), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Filter)(nil), (*v1alpha4.Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_Filter_To_v1alpha4_Filter(a.(*Filter), b.(*v1alpha4.Filter), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Filter)(nil), (*Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Filter_To_v1alpha3_Filter(a.(*v1beta1.Filter), b.(*Filter), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.Filter)(nil), (*Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_Filter_To_v1alpha3_Filter(a.(*v1alpha4.Filter), b.(*Filter), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPCluster)(nil), (*v1beta1.GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(a.(*GCPCluster), b.(*v1beta1.GCPCluster), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPCluster)(nil), (*v1alpha4.GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(a.(*GCPCluster), b.(*v1alpha4.GCPCluster), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPCluster)(nil), (*GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(a.(*v1beta1.GCPCluster), b.(*GCPCluster), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPCluster)(nil), (*GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(a.(*v1alpha4.GCPCluster), b.(*GCPCluster), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPClusterList)(nil), (*v1beta1.GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList(a.(*GCPClusterList), b.(*v1beta1.GCPClusterList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPClusterList)(nil), (*v1alpha4.GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(a.(*GCPClusterList), b.(*v1alpha4.GCPClusterList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterList)(nil), (*GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList(a.(*v1beta1.GCPClusterList), b.(*GCPClusterList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPClusterList)(nil), (*GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(a.(*v1alpha4.GCPClusterList), b.(*GCPClusterList), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachine)(nil), (*v1beta1.GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(a.(*GCPMachine), b.(*v1beta1.GCPMachine), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachine)(nil), (*v1alpha4.GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(a.(*GCPMachine), b.(*v1alpha4.GCPMachine), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachine)(nil), (*GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(a.(*v1beta1.GCPMachine), b.(*GCPMachine), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachine)(nil), (*GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(a.(*v1alpha4.GCPMachine), b.(*GCPMachine), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachineList)(nil), (*v1beta1.GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList(a.(*GCPMachineList), b.(*v1beta1.GCPMachineList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachineList)(nil), (*v1alpha4.GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(a.(*GCPMachineList), b.(*v1alpha4.GCPMachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineList)(nil), (*GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList(a.(*v1beta1.GCPMachineList), b.(*GCPMachineList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachineList)(nil), (*GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(a.(*v1alpha4.GCPMachineList), b.(*GCPMachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplate)(nil), (*v1beta1.GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(a.(*GCPMachineTemplate), b.(*v1beta1.GCPMachineTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplate)(nil), (*v1alpha4.GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(a.(*GCPMachineTemplate), b.(*v1alpha4.GCPMachineTemplate), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateList)(nil), (*v1beta1.GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(a.(*GCPMachineTemplateList), b.(*v1beta1.GCPMachineTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachineTemplate)(nil), (*GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(a.(*v1alpha4.GCPMachineTemplate), b.(*GCPMachineTemplate), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineTemplateList)(nil), (*GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(a.(*v1beta1.GCPMachineTemplateList), b.(*GCPMachineTemplateList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateList)(nil), (*v1alpha4.GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(a.(*GCPMachineTemplateList), b.(*v1alpha4.GCPMachineTemplateList), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateResource)(nil), (*v1beta1.GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(a.(*GCPMachineTemplateResource), b.(*v1beta1.GCPMachineTemplateResource), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachineTemplateList)(nil), (*GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(a.(*v1alpha4.GCPMachineTemplateList), b.(*GCPMachineTemplateList), scope)
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateSpec)(nil), (*v1beta1.GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(a.(*GCPMachineTemplateSpec), b.(*v1beta1.GCPMachineTemplateSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateResource)(nil), (*v1alpha4.GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(a.(*GCPMachineTemplateResource), b.(*v1alpha4.GCPMachineTemplateResource), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineTemplateSpec)(nil), (*GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(a.(*v1beta1.GCPMachineTemplateSpec), b.(*GCPMachineTemplateSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachineTemplateResource)(nil), (*GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(a.(*v1alpha4.GCPMachineTemplateResource), b.(*GCPMachineTemplateResource), scope)
+	if err := s.AddGeneratedConversionFunc((*MetadataItem)(nil), (*v1beta1.MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_MetadataItem_To_v1beta1_MetadataItem(a.(*MetadataItem), b.(*v1beta1.MetadataItem), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateSpec)(nil), (*v1alpha4.GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(a.(*GCPMachineTemplateSpec), b.(*v1alpha4.GCPMachineTemplateSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetadataItem)(nil), (*MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetadataItem_To_v1alpha3_MetadataItem(a.(*v1beta1.MetadataItem), b.(*MetadataItem), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.GCPMachineTemplateSpec)(nil), (*GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(a.(*v1alpha4.GCPMachineTemplateSpec), b.(*GCPMachineTemplateSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*Network)(nil), (*v1beta1.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_Network_To_v1beta1_Network(a.(*Network), b.(*v1beta1.Network), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MetadataItem)(nil), (*v1alpha4.MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_MetadataItem_To_v1alpha4_MetadataItem(a.(*MetadataItem), b.(*v1alpha4.MetadataItem), scope)
+	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*v1beta1.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ServiceAccount_To_v1beta1_ServiceAccount(a.(*ServiceAccount), b.(*v1beta1.ServiceAccount), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.MetadataItem)(nil), (*MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_MetadataItem_To_v1alpha3_MetadataItem(a.(*v1alpha4.MetadataItem), b.(*MetadataItem), scope)
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ServiceAccount)(nil), (*ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceAccount_To_v1alpha3_ServiceAccount(a.(*v1beta1.ServiceAccount), b.(*ServiceAccount), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Network)(nil), (*v1alpha4.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_Network_To_v1alpha4_Network(a.(*Network), b.(*v1alpha4.Network), scope)
+	if err := s.AddGeneratedConversionFunc((*SubnetSpec)(nil), (*v1beta1.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec(a.(*SubnetSpec), b.(*v1beta1.SubnetSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_Network_To_v1alpha3_Network(a.(*v1alpha4.Network), b.(*Network), scope)
+	if err := s.AddConversionFunc((**SubnetSpec)(nil), (**v1beta1.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1alpha3_SubnetSpec_To_Pointer_v1beta1_SubnetSpec(a.(**SubnetSpec), b.(**v1beta1.SubnetSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*v1alpha4.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_ServiceAccount_To_v1alpha4_ServiceAccount(a.(*ServiceAccount), b.(*v1alpha4.ServiceAccount), scope)
+	if err := s.AddConversionFunc((**v1beta1.SubnetSpec)(nil), (**SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta1_SubnetSpec_To_Pointer_v1alpha3_SubnetSpec(a.(**v1beta1.SubnetSpec), b.(**SubnetSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.ServiceAccount)(nil), (*ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_ServiceAccount_To_v1alpha3_ServiceAccount(a.(*v1alpha4.ServiceAccount), b.(*ServiceAccount), scope)
+	if err := s.AddConversionFunc((*apiv1alpha3.APIEndpoint)(nil), (*v1alpha4.APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_APIEndpoint_To_v1alpha4_APIEndpoint(a.(*apiv1alpha3.APIEndpoint), b.(*v1alpha4.APIEndpoint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SubnetSpec)(nil), (*v1alpha4.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(a.(*SubnetSpec), b.(*v1alpha4.SubnetSpec), scope)
+	if err := s.AddConversionFunc((*GCPClusterSpec)(nil), (*v1beta1.GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec(a.(*GCPClusterSpec), b.(*v1beta1.GCPClusterSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha4.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(a.(*v1alpha4.SubnetSpec), b.(*SubnetSpec), scope)
+	if err := s.AddConversionFunc((*GCPClusterStatus)(nil), (*v1beta1.GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus(a.(*GCPClusterStatus), b.(*v1beta1.GCPClusterStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*apiv1alpha3.APIEndpoint)(nil), (*apiv1alpha4.APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_APIEndpoint_To_v1alpha4_APIEndpoint(a.(*apiv1alpha3.APIEndpoint), b.(*apiv1alpha4.APIEndpoint), scope)
+	if err := s.AddConversionFunc((*GCPMachineSpec)(nil), (*v1beta1.GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(a.(*GCPMachineSpec), b.(*v1beta1.GCPMachineSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*GCPClusterSpec)(nil), (*v1alpha4.GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(a.(*GCPClusterSpec), b.(*v1alpha4.GCPClusterSpec), scope)
+	if err := s.AddConversionFunc((*GCPMachineStatus)(nil), (*v1beta1.GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus(a.(*GCPMachineStatus), b.(*v1beta1.GCPMachineStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*GCPClusterStatus)(nil), (*v1alpha4.GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(a.(*GCPClusterStatus), b.(*v1alpha4.GCPClusterStatus), scope)
+	if err := s.AddConversionFunc((*NetworkSpec)(nil), (*v1beta1.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec(a.(*NetworkSpec), b.(*v1beta1.NetworkSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*GCPMachineSpec)(nil), (*v1alpha4.GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(a.(*GCPMachineSpec), b.(*v1alpha4.GCPMachineSpec), scope)
+	if err := s.AddConversionFunc((*v1alpha4.APIEndpoint)(nil), (*apiv1alpha3.APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_APIEndpoint_To_v1alpha3_APIEndpoint(a.(*v1alpha4.APIEndpoint), b.(*apiv1alpha3.APIEndpoint), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*GCPMachineStatus)(nil), (*v1alpha4.GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(a.(*GCPMachineStatus), b.(*v1alpha4.GCPMachineStatus), scope)
+	if err := s.AddConversionFunc((*v1beta1.AttachedDiskSpec)(nil), (*AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(a.(*v1beta1.AttachedDiskSpec), b.(*AttachedDiskSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*NetworkSpec)(nil), (*v1alpha4.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha3_NetworkSpec_To_v1alpha4_NetworkSpec(a.(*NetworkSpec), b.(*v1alpha4.NetworkSpec), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPClusterSpec)(nil), (*GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(a.(*v1beta1.GCPClusterSpec), b.(*GCPClusterSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*apiv1alpha4.APIEndpoint)(nil), (*apiv1alpha3.APIEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_APIEndpoint_To_v1alpha3_APIEndpoint(a.(*apiv1alpha4.APIEndpoint), b.(*apiv1alpha3.APIEndpoint), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPClusterStatus)(nil), (*GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(a.(*v1beta1.GCPClusterStatus), b.(*GCPClusterStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha4.GCPClusterSpec)(nil), (*GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(a.(*v1alpha4.GCPClusterSpec), b.(*GCPClusterSpec), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPMachineSpec)(nil), (*GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(a.(*v1beta1.GCPMachineSpec), b.(*GCPMachineSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha4.GCPClusterStatus)(nil), (*GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(a.(*v1alpha4.GCPClusterStatus), b.(*GCPClusterStatus), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPMachineStatus)(nil), (*GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(a.(*v1beta1.GCPMachineStatus), b.(*GCPMachineStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha4.GCPMachineSpec)(nil), (*GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(a.(*v1alpha4.GCPMachineSpec), b.(*GCPMachineSpec), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPMachineTemplateResource)(nil), (*GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(a.(*v1beta1.GCPMachineTemplateResource), b.(*GCPMachineTemplateResource), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha4.GCPMachineStatus)(nil), (*GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(a.(*v1alpha4.GCPMachineStatus), b.(*GCPMachineStatus), scope)
+	if err := s.AddConversionFunc((*v1beta1.GCPMachineTemplate)(nil), (*GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(a.(*v1beta1.GCPMachineTemplate), b.(*GCPMachineTemplate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*v1alpha4.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha4_NetworkSpec_To_v1alpha3_NetworkSpec(a.(*v1alpha4.NetworkSpec), b.(*NetworkSpec), scope)
+	if err := s.AddConversionFunc((*v1beta1.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec(a.(*v1beta1.NetworkSpec), b.(*NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Network_To_v1alpha3_Network(a.(*v1beta1.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(a.(*v1beta1.SubnetSpec), b.(*SubnetSpec), scope)
 	}); err != nil {
 		return err
 	}
 	return nil
 }
 
-func autoConvert_v1alpha3_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in *AttachedDiskSpec, out *v1alpha4.AttachedDiskSpec, s conversion.Scope) error {
-	out.DeviceType = (*v1alpha4.DiskType)(unsafe.Pointer(in.DeviceType))
+func autoConvert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in *AttachedDiskSpec, out *v1beta1.AttachedDiskSpec, s conversion.Scope) error {
+	out.DeviceType = (*v1beta1.DiskType)(unsafe.Pointer(in.DeviceType))
 	out.Size = (*int64)(unsafe.Pointer(in.Size))
 	return nil
 }
 
-// Convert_v1alpha3_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec is an autogenerated conversion function.
-func Convert_v1alpha3_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in *AttachedDiskSpec, out *v1alpha4.AttachedDiskSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in, out, s)
+// Convert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in *AttachedDiskSpec, out *v1beta1.AttachedDiskSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in, out, s)
 }
 
-func autoConvert_v1alpha4_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in *v1alpha4.AttachedDiskSpec, out *AttachedDiskSpec, s conversion.Scope) error {
+func autoConvert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in *v1beta1.AttachedDiskSpec, out *AttachedDiskSpec, s conversion.Scope) error {
 	out.DeviceType = (*DiskType)(unsafe.Pointer(in.DeviceType))
 	out.Size = (*int64)(unsafe.Pointer(in.Size))
+	// WARNING: in.Name requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProvisionedIOPS requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceImage requires manual conversion: does not exist in peer-type
+	// WARNING: in.Labels requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoDelete requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourcePolicies requires manual conversion: does not exist in peer-type
+	// WARNING: in.Count requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha4_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec is an autogenerated conversion function.
-func Convert_v1alpha4_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in *v1alpha4.AttachedDiskSpec, out *AttachedDiskSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha4_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(in, out, s)
-}
-
-func autoConvert_v1alpha3_BuildParams_To_v1alpha4_BuildParams(in *BuildParams, out *v1alpha4.BuildParams, s conversion.Scope) error {
-	out.Lifecycle = v1alpha4.ResourceLifecycle(in.Lifecycle)
+func autoConvert_v1alpha3_BuildParams_To_v1beta1_BuildParams(in *BuildParams, out *v1beta1.BuildParams, s conversion.Scope) error {
+	out.Lifecycle = v1beta1.ResourceLifecycle(in.Lifecycle)
 	out.ClusterName = in.ClusterName
 	out.ResourceID = in.ResourceID
 	out.Role = (*string)(unsafe.Pointer(in.Role))
-	out.Additional = *(*v1alpha4.Labels)(unsafe.Pointer(&in.Additional))
+	out.Additional = *(*v1beta1.Labels)(unsafe.Pointer(&in.Additional))
 	return nil
 }
 
-// Convert_v1alpha3_BuildParams_To_v1alpha4_BuildParams is an autogenerated conversion function.
-func Convert_v1alpha3_BuildParams_To_v1alpha4_BuildParams(in *BuildParams, out *v1alpha4.BuildParams, s conversion.Scope) error {
-	return autoConvert_v1alpha3_BuildParams_To_v1alpha4_BuildParams(in, out, s)
+// Convert_v1alpha3_BuildParams_To_v1beta1_BuildParams is an autogenerated conversion function.
+func Convert_v1alpha3_BuildParams_To_v1beta1_BuildParams(in *BuildParams, out *v1beta1.BuildParams, s conversion.Scope) error {
+	return autoConvert_v1alpha3_BuildParams_To_v1beta1_BuildParams(in, out, s)
 }
 
-func autoConvert_v1alpha4_BuildParams_To_v1alpha3_BuildParams(in *v1alpha4.BuildParams, out *BuildParams, s conversion.Scope) error {
+func autoConvert_v1beta1_BuildParams_To_v1alpha3_BuildParams(in *v1beta1.BuildParams, out *BuildParams, s conversion.Scope) error {
 	out.Lifecycle = ResourceLifecycle(in.Lifecycle)
 	out.ClusterName = in.ClusterName
 	out.ResourceID = in.ResourceID
@@ -297,72 +310,72 @@ func autoConvert_v1alpha4_BuildParams_To_v1alpha3_BuildParams(in *v1alpha4.Build
 	return nil
 }
 
-// Convert_v1alpha4_BuildParams_To_v1alpha3_BuildParams is an autogenerated conversion function.
-func Convert_v1alpha4_BuildParams_To_v1alpha3_BuildParams(in *v1alpha4.BuildParams, out *BuildParams, s conversion.Scope) error {
-	return autoConvert_v1alpha4_BuildParams_To_v1alpha3_BuildParams(in, out, s)
+// Convert_v1beta1_BuildParams_To_v1alpha3_BuildParams is an autogenerated conversion function.
+func Convert_v1beta1_BuildParams_To_v1alpha3_BuildParams(in *v1beta1.BuildParams, out *BuildParams, s conversion.Scope) error {
+	return autoConvert_v1beta1_BuildParams_To_v1alpha3_BuildParams(in, out, s)
 }
 
-func autoConvert_v1alpha3_Filter_To_v1alpha4_Filter(in *Filter, out *v1alpha4.Filter, s conversion.Scope) error {
+func autoConvert_v1alpha3_Filter_To_v1beta1_Filter(in *Filter, out *v1beta1.Filter, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
 	return nil
 }
 
-// Convert_v1alpha3_Filter_To_v1alpha4_Filter is an autogenerated conversion function.
-func Convert_v1alpha3_Filter_To_v1alpha4_Filter(in *Filter, out *v1alpha4.Filter, s conversion.Scope) error {
-	return autoConvert_v1alpha3_Filter_To_v1alpha4_Filter(in, out, s)
+// Convert_v1alpha3_Filter_To_v1beta1_Filter is an autogenerated conversion function.
+func Convert_v1alpha3_Filter_To_v1beta1_Filter(in *Filter, out *v1beta1.Filter, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Filter_To_v1beta1_Filter(in, out, s)
 }
 
-func autoConvert_v1alpha4_Filter_To_v1alpha3_Filter(in *v1alpha4.Filter, out *Filter, s conversion.Scope) error {
+func autoConvert_v1beta1_Filter_To_v1alpha3_Filter(in *v1beta1.Filter, out *Filter, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
 	return nil
 }
 
-// Convert_v1alpha4_Filter_To_v1alpha3_Filter is an autogenerated conversion function.
-func Convert_v1alpha4_Filter_To_v1alpha3_Filter(in *v1alpha4.Filter, out *Filter, s conversion.Scope) error {
-	return autoConvert_v1alpha4_Filter_To_v1alpha3_Filter(in, out, s)
+// Convert_v1beta1_Filter_To_v1alpha3_Filter is an autogenerated conversion function.
+func Convert_v1beta1_Filter_To_v1alpha3_Filter(in *v1beta1.Filter, out *Filter, s conversion.Scope) error {
+	return autoConvert_v1beta1_Filter_To_v1alpha3_Filter(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(in *GCPCluster, out *v1alpha4.GCPCluster, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(in *GCPCluster, out *v1beta1.GCPCluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster is an autogenerated conversion function.
-func Convert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(in *GCPCluster, out *v1alpha4.GCPCluster, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(in, out, s)
+// Convert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster is an autogenerated conversion function.
+func Convert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(in *GCPCluster, out *v1beta1.GCPCluster, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(in *v1alpha4.GCPCluster, out *GCPCluster, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(in *v1beta1.GCPCluster, out *GCPCluster, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster is an autogenerated conversion function.
-func Convert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(in *v1alpha4.GCPCluster, out *GCPCluster, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(in, out, s)
+// Convert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster is an autogenerated conversion function.
+func Convert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(in *v1beta1.GCPCluster, out *GCPCluster, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(in *GCPClusterList, out *v1alpha4.GCPClusterList, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList(in *GCPClusterList, out *v1beta1.GCPClusterList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1alpha4.GCPCluster, len(*in))
+		*out = make([]v1beta1.GCPCluster, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha3_GCPCluster_To_v1alpha4_GCPCluster(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha3_GCPCluster_To_v1beta1_GCPCluster(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -372,18 +385,18 @@ func autoConvert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(in *GCPClust
 	return nil
 }
 
-// Convert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList is an autogenerated conversion function.
-func Convert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(in *GCPClusterList, out *v1alpha4.GCPClusterList, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPClusterList_To_v1alpha4_GCPClusterList(in, out, s)
+// Convert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList is an autogenerated conversion function.
+func Convert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList(in *GCPClusterList, out *v1beta1.GCPClusterList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPClusterList_To_v1beta1_GCPClusterList(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(in *v1alpha4.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList(in *v1beta1.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]GCPCluster, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha4_GCPCluster_To_v1alpha3_GCPCluster(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1beta1_GCPCluster_To_v1alpha3_GCPCluster(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -393,96 +406,104 @@ func autoConvert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(in *v1alpha4
 	return nil
 }
 
-// Convert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList is an autogenerated conversion function.
-func Convert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(in *v1alpha4.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPClusterList_To_v1alpha3_GCPClusterList(in, out, s)
+// Convert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList(in *v1beta1.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterList_To_v1alpha3_GCPClusterList(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in *GCPClusterSpec, out *v1alpha4.GCPClusterSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in *GCPClusterSpec, out *v1beta1.GCPClusterSpec, s conversion.Scope) error {
 	out.Project = in.Project
 	out.Region = in.Region
 	if err := Convert_v1alpha3_APIEndpoint_To_v1alpha4_APIEndpoint(&in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha3_NetworkSpec_To_v1alpha4_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+	if err := Convert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
 	out.FailureDomains = *(*[]string)(unsafe.Pointer(&in.FailureDomains))
-	out.AdditionalLabels = *(*v1alpha4.Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.AdditionalLabels = *(*v1beta1.Labels)(unsafe.Pointer(&in.AdditionalLabels))
 	return nil
 }
 
-func autoConvert_v1alpha4_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in *v1alpha4.GCPClusterSpec, out *GCPClusterSpec, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPClusterSpec_To_v1alpha3_GCPClusterSpec(in *v1beta1.GCPClusterSpec, out *GCPClusterSpec, s conversion.Scope) error {
 	out.Project = in.Project
 	out.Region = in.Region
+	// WARNING: in.ResourceNamePrefix requires manual conversion: does not exist in peer-type
 	if err := Convert_v1alpha4_APIEndpoint_To_v1alpha3_APIEndpoint(&in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha4_NetworkSpec_To_v1alpha3_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+	if err := Convert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkTier requires manual conversion: does not exist in peer-type
+	// WARNING: in.LoadBalancer requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneAccess requires manual conversion: does not exist in peer-type
 	out.FailureDomains = *(*[]string)(unsafe.Pointer(&in.FailureDomains))
 	out.AdditionalLabels = *(*Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	// WARNING: in.PlacementPolicies requires manual conversion: does not exist in peer-type
+	// WARNING: in.Bastion requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-func autoConvert_v1alpha3_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in *GCPClusterStatus, out *v1alpha4.GCPClusterStatus, s conversion.Scope) error {
-	out.FailureDomains = *(*apiv1alpha4.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
-	if err := Convert_v1alpha3_Network_To_v1alpha4_Network(&in.Network, &out.Network, s); err != nil {
+func autoConvert_v1alpha3_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in *GCPClusterStatus, out *v1beta1.GCPClusterStatus, s conversion.Scope) error {
+	out.FailureDomains = *(*v1alpha4.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
+	if err := Convert_v1alpha3_Network_To_v1beta1_Network(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
 	out.Ready = in.Ready
 	return nil
 }
 
-func autoConvert_v1alpha4_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in *v1alpha4.GCPClusterStatus, out *GCPClusterStatus, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPClusterStatus_To_v1alpha3_GCPClusterStatus(in *v1beta1.GCPClusterStatus, out *GCPClusterStatus, s conversion.Scope) error {
 	out.FailureDomains = *(*apiv1alpha3.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
-	if err := Convert_v1alpha4_Network_To_v1alpha3_Network(&in.Network, &out.Network, s); err != nil {
+	if err := Convert_v1beta1_Network_To_v1alpha3_Network(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
+	// WARNING: in.Bastion requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	return nil
 }
 
-func autoConvert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(in *GCPMachine, out *v1alpha4.GCPMachine, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(in *GCPMachine, out *v1beta1.GCPMachine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(in *GCPMachine, out *v1alpha4.GCPMachine, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(in, out, s)
+// Convert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(in *GCPMachine, out *v1beta1.GCPMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(in *v1alpha4.GCPMachine, out *GCPMachine, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(in *v1beta1.GCPMachine, out *GCPMachine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(in *v1alpha4.GCPMachine, out *GCPMachine, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(in, out, s)
+// Convert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(in *v1beta1.GCPMachine, out *GCPMachine, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(in *GCPMachineList, out *v1alpha4.GCPMachineList, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList(in *GCPMachineList, out *v1beta1.GCPMachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1alpha4.GCPMachine, len(*in))
+		*out = make([]v1beta1.GCPMachine, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha3_GCPMachine_To_v1alpha4_GCPMachine(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha3_GCPMachine_To_v1beta1_GCPMachine(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -492,18 +513,18 @@ func autoConvert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(in *GCPMachi
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(in *GCPMachineList, out *v1alpha4.GCPMachineList, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachineList_To_v1alpha4_GCPMachineList(in, out, s)
+// Convert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList(in *GCPMachineList, out *v1beta1.GCPMachineList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachineList_To_v1beta1_GCPMachineList(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(in *v1alpha4.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList(in *v1beta1.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]GCPMachine, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha4_GCPMachine_To_v1alpha3_GCPMachine(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1beta1_GCPMachine_To_v1alpha3_GCPMachine(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -513,98 +534,136 @@ func autoConvert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(in *v1alpha4
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(in *v1alpha4.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachineList_To_v1alpha3_GCPMachineList(in, out, s)
+// Convert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList(in *v1beta1.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineList_To_v1alpha3_GCPMachineList(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in *GCPMachineSpec, out *v1alpha4.GCPMachineSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in *GCPMachineSpec, out *v1beta1.GCPMachineSpec, s conversion.Scope) error {
 	out.InstanceType = in.InstanceType
 	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
 	out.ImageFamily = (*string)(unsafe.Pointer(in.ImageFamily))
 	out.Image = (*string)(unsafe.Pointer(in.Image))
-	out.AdditionalLabels = *(*v1alpha4.Labels)(unsafe.Pointer(&in.AdditionalLabels))
-	out.AdditionalMetadata = *(*[]v1alpha4.MetadataItem)(unsafe.Pointer(&in.AdditionalMetadata))
+	out.AdditionalLabels = *(*v1beta1.Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.AdditionalMetadata = *(*[]v1beta1.MetadataItem)(unsafe.Pointer(&in.AdditionalMetadata))
 	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
 	out.AdditionalNetworkTags = *(*[]string)(unsafe.Pointer(&in.AdditionalNetworkTags))
 	out.RootDeviceSize = in.RootDeviceSize
-	out.RootDeviceType = (*v1alpha4.DiskType)(unsafe.Pointer(in.RootDeviceType))
-	out.AdditionalDisks = *(*[]v1alpha4.AttachedDiskSpec)(unsafe.Pointer(&in.AdditionalDisks))
-	out.ServiceAccount = (*v1alpha4.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
+	out.RootDeviceType = (*v1beta1.DiskType)(unsafe.Pointer(in.RootDeviceType))
+	if in.AdditionalDisks != nil {
+		in, out := &in.AdditionalDisks, &out.AdditionalDisks
+		*out = make([]v1beta1.AttachedDiskSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalDisks = nil
+	}
+	out.ServiceAccount = (*v1beta1.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.Preemptible = in.Preemptible
 	return nil
 }
 
-func autoConvert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in *v1alpha4.GCPMachineSpec, out *GCPMachineSpec, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(in *v1beta1.GCPMachineSpec, out *GCPMachineSpec, s conversion.Scope) error {
 	out.InstanceType = in.InstanceType
+	// WARNING: in.CustomMachineType requires manual conversion: does not exist in peer-type
+	// WARNING: in.MinCPUPlatform requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdvancedMachineFeatures requires manual conversion: does not exist in peer-type
+	// WARNING: in.GuestAccelerators requires manual conversion: does not exist in peer-type
 	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	// WARNING: in.NetworkInterfaces requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkTier requires manual conversion: does not exist in peer-type
+	// WARNING: in.AddressReservations requires manual conversion: does not exist in peer-type
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomainFallback requires manual conversion: does not exist in peer-type
 	out.ImageFamily = (*string)(unsafe.Pointer(in.ImageFamily))
 	out.Image = (*string)(unsafe.Pointer(in.Image))
+	// WARNING: in.ImageLookup requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageFamilyTemplate requires manual conversion: does not exist in peer-type
 	out.AdditionalLabels = *(*Labels)(unsafe.Pointer(&in.AdditionalLabels))
 	out.AdditionalMetadata = *(*[]MetadataItem)(unsafe.Pointer(&in.AdditionalMetadata))
 	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
 	out.AdditionalNetworkTags = *(*[]string)(unsafe.Pointer(&in.AdditionalNetworkTags))
 	out.RootDeviceSize = in.RootDeviceSize
 	out.RootDeviceType = (*DiskType)(unsafe.Pointer(in.RootDeviceType))
-	out.AdditionalDisks = *(*[]AttachedDiskSpec)(unsafe.Pointer(&in.AdditionalDisks))
+	if in.AdditionalDisks != nil {
+		in, out := &in.AdditionalDisks, &out.AdditionalDisks
+		*out = make([]AttachedDiskSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_AttachedDiskSpec_To_v1alpha3_AttachedDiskSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalDisks = nil
+	}
 	out.ServiceAccount = (*ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.Preemptible = in.Preemptible
+	// WARNING: in.NodeAffinities requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReservationAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapDataStorage requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapDataBucket requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-func autoConvert_v1alpha3_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in *GCPMachineStatus, out *v1alpha4.GCPMachineStatus, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in *GCPMachineStatus, out *v1beta1.GCPMachineStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
-	out.InstanceStatus = (*v1alpha4.InstanceStatus)(unsafe.Pointer(in.InstanceStatus))
+	out.InstanceStatus = (*v1beta1.InstanceStatus)(unsafe.Pointer(in.InstanceStatus))
 	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	return nil
 }
 
-func autoConvert_v1alpha4_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in *v1alpha4.GCPMachineStatus, out *GCPMachineStatus, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachineStatus_To_v1alpha3_GCPMachineStatus(in *v1beta1.GCPMachineStatus, out *GCPMachineStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
 	out.InstanceStatus = (*InstanceStatus)(unsafe.Pointer(in.InstanceStatus))
+	// WARNING: in.InstanceName requires manual conversion: does not exist in peer-type
+	// WARNING: in.Image requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapDataSecret requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapDataObject requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReservedInternalAddress requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReservedExternalAddress requires manual conversion: does not exist in peer-type
 	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	return nil
 }
 
-func autoConvert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in *GCPMachineTemplate, out *v1alpha4.GCPMachineTemplate, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in *GCPMachineTemplate, out *v1beta1.GCPMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in *GCPMachineTemplate, out *v1alpha4.GCPMachineTemplate, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in, out, s)
+// Convert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in *GCPMachineTemplate, out *v1beta1.GCPMachineTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in *v1alpha4.GCPMachineTemplate, out *GCPMachineTemplate, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in *v1beta1.GCPMachineTemplate, out *GCPMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	// WARNING: in.Status requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in *v1alpha4.GCPMachineTemplate, out *GCPMachineTemplate, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(in, out, s)
-}
-
-func autoConvert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1alpha4.GCPMachineTemplateList, s conversion.Scope) error {
+func autoConvert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1beta1.GCPMachineTemplateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]v1alpha4.GCPMachineTemplate, len(*in))
+		*out = make([]v1beta1.GCPMachineTemplate, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha3_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha3_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -614,18 +673,18 @@ func autoConvert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateL
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1alpha4.GCPMachineTemplateList, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in, out, s)
+// Convert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1beta1.GCPMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in *v1alpha4.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
+func autoConvert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in *v1beta1.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]GCPMachineTemplate, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha4_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1beta1_GCPMachineTemplate_To_v1alpha3_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
@@ -635,82 +694,78 @@ func autoConvert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateL
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in *v1alpha4.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in, out, s)
+// Convert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in *v1beta1.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineTemplateList_To_v1alpha3_GCPMachineTemplateList(in, out, s)
 }
 
-func autoConvert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1alpha4.GCPMachineTemplateResource, s conversion.Scope) error {
-	if err := Convert_v1alpha3_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+func autoConvert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1beta1.GCPMachineTemplateResource, s conversion.Scope) error {
+	if err := Convert_v1alpha3_GCPMachineSpec_To_v1beta1_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1alpha4.GCPMachineTemplateResource, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in, out, s)
+// Convert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1beta1.GCPMachineTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in *v1alpha4.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s conversion.Scope) error {
-	if err := Convert_v1alpha4_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+func autoConvert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in *v1beta1.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s conversion.Scope) error {
+	// WARNING: in.ObjectMeta requires manual conversion: does not exist in peer-type
+	if err := Convert_v1beta1_GCPMachineSpec_To_v1alpha3_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in *v1alpha4.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(in, out, s)
-}
-
-func autoConvert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1alpha4.GCPMachineTemplateSpec, s conversion.Scope) error {
-	if err := Convert_v1alpha3_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+func autoConvert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1beta1.GCPMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha3_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec is an autogenerated conversion function.
-func Convert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1alpha4.GCPMachineTemplateSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in, out, s)
+// Convert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1beta1.GCPMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in, out, s)
 }
 
-func autoConvert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in *v1alpha4.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
-	if err := Convert_v1alpha4_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+func autoConvert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in *v1beta1.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha3_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec is an autogenerated conversion function.
-func Convert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in *v1alpha4.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha4_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in, out, s)
+// Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in *v1beta1.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineTemplateSpec_To_v1alpha3_GCPMachineTemplateSpec(in, out, s)
 }
 
-func autoConvert_v1alpha3_MetadataItem_To_v1alpha4_MetadataItem(in *MetadataItem, out *v1alpha4.MetadataItem, s conversion.Scope) error {
+func autoConvert_v1alpha3_MetadataItem_To_v1beta1_MetadataItem(in *MetadataItem, out *v1beta1.MetadataItem, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
 	return nil
 }
 
-// Convert_v1alpha3_MetadataItem_To_v1alpha4_MetadataItem is an autogenerated conversion function.
-func Convert_v1alpha3_MetadataItem_To_v1alpha4_MetadataItem(in *MetadataItem, out *v1alpha4.MetadataItem, s conversion.Scope) error {
-	return autoConvert_v1alpha3_MetadataItem_To_v1alpha4_MetadataItem(in, out, s)
+// Convert_v1alpha3_MetadataItem_To_v1beta1_MetadataItem is an autogenerated conversion function.
+func Convert_v1alpha3_MetadataItem_To_v1beta1_MetadataItem(in *MetadataItem, out *v1beta1.MetadataItem, s conversion.Scope) error {
+	return autoConvert_v1alpha3_MetadataItem_To_v1beta1_MetadataItem(in, out, s)
 }
 
-func autoConvert_v1alpha4_MetadataItem_To_v1alpha3_MetadataItem(in *v1alpha4.MetadataItem, out *MetadataItem, s conversion.Scope) error {
+func autoConvert_v1beta1_MetadataItem_To_v1alpha3_MetadataItem(in *v1beta1.MetadataItem, out *MetadataItem, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
 	return nil
 }
 
-// Convert_v1alpha4_MetadataItem_To_v1alpha3_MetadataItem is an autogenerated conversion function.
-func Convert_v1alpha4_MetadataItem_To_v1alpha3_MetadataItem(in *v1alpha4.MetadataItem, out *MetadataItem, s conversion.Scope) error {
-	return autoConvert_v1alpha4_MetadataItem_To_v1alpha3_MetadataItem(in, out, s)
+// Convert_v1beta1_MetadataItem_To_v1alpha3_MetadataItem is an autogenerated conversion function.
+func Convert_v1beta1_MetadataItem_To_v1alpha3_MetadataItem(in *v1beta1.MetadataItem, out *MetadataItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetadataItem_To_v1alpha3_MetadataItem(in, out, s)
 }
 
-func autoConvert_v1alpha3_Network_To_v1alpha4_Network(in *Network, out *v1alpha4.Network, s conversion.Scope) error {
+func autoConvert_v1alpha3_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
 	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
 	out.FirewallRules = *(*map[string]string)(unsafe.Pointer(&in.FirewallRules))
 	out.Router = (*string)(unsafe.Pointer(in.Router))
@@ -723,68 +778,88 @@ func autoConvert_v1alpha3_Network_To_v1alpha4_Network(in *Network, out *v1alpha4
 	return nil
 }
 
-// Convert_v1alpha3_Network_To_v1alpha4_Network is an autogenerated conversion function.
-func Convert_v1alpha3_Network_To_v1alpha4_Network(in *Network, out *v1alpha4.Network, s conversion.Scope) error {
-	return autoConvert_v1alpha3_Network_To_v1alpha4_Network(in, out, s)
+// Convert_v1alpha3_Network_To_v1beta1_Network is an autogenerated conversion function.
+func Convert_v1alpha3_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
+	return autoConvert_v1alpha3_Network_To_v1beta1_Network(in, out, s)
 }
 
-func autoConvert_v1alpha4_Network_To_v1alpha3_Network(in *v1alpha4.Network, out *Network, s conversion.Scope) error {
+func autoConvert_v1beta1_Network_To_v1alpha3_Network(in *v1beta1.Network, out *Network, s conversion.Scope) error {
 	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
 	out.FirewallRules = *(*map[string]string)(unsafe.Pointer(&in.FirewallRules))
 	out.Router = (*string)(unsafe.Pointer(in.Router))
 	out.APIServerAddress = (*string)(unsafe.Pointer(in.APIServerAddress))
+	// WARNING: in.APIServerIP requires manual conversion: does not exist in peer-type
 	out.APIServerHealthCheck = (*string)(unsafe.Pointer(in.APIServerHealthCheck))
 	out.APIServerInstanceGroups = *(*map[string]string)(unsafe.Pointer(&in.APIServerInstanceGroups))
 	out.APIServerBackendService = (*string)(unsafe.Pointer(in.APIServerBackendService))
 	out.APIServerTargetProxy = (*string)(unsafe.Pointer(in.APIServerTargetProxy))
 	out.APIServerForwardingRule = (*string)(unsafe.Pointer(in.APIServerForwardingRule))
+	// WARNING: in.APIServerIPv6Address requires manual conversion: does not exist in peer-type
+	// WARNING: in.APIServerIPv6 requires manual conversion: does not exist in peer-type
+	// WARNING: in.APIServerIPv6ForwardingRule requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha4_Network_To_v1alpha3_Network is an autogenerated conversion function.
-func Convert_v1alpha4_Network_To_v1alpha3_Network(in *v1alpha4.Network, out *Network, s conversion.Scope) error {
-	return autoConvert_v1alpha4_Network_To_v1alpha3_Network(in, out, s)
-}
-
-func autoConvert_v1alpha3_NetworkSpec_To_v1alpha4_NetworkSpec(in *NetworkSpec, out *v1alpha4.NetworkSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_NetworkSpec_To_v1beta1_NetworkSpec(in *NetworkSpec, out *v1beta1.NetworkSpec, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.AutoCreateSubnetworks = (*bool)(unsafe.Pointer(in.AutoCreateSubnetworks))
-	out.Subnets = *(*v1alpha4.Subnets)(unsafe.Pointer(&in.Subnets))
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(v1beta1.Subnets, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1alpha3_SubnetSpec_To_Pointer_v1beta1_SubnetSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subnets = nil
+	}
 	out.LoadBalancerBackendPort = (*int32)(unsafe.Pointer(in.LoadBalancerBackendPort))
 	return nil
 }
 
-func autoConvert_v1alpha4_NetworkSpec_To_v1alpha3_NetworkSpec(in *v1alpha4.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+func autoConvert_v1beta1_NetworkSpec_To_v1alpha3_NetworkSpec(in *v1beta1.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.AutoCreateSubnetworks = (*bool)(unsafe.Pointer(in.AutoCreateSubnetworks))
-	out.Subnets = *(*Subnets)(unsafe.Pointer(&in.Subnets))
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1beta1_SubnetSpec_To_Pointer_v1alpha3_SubnetSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subnets = nil
+	}
 	out.LoadBalancerBackendPort = (*int32)(unsafe.Pointer(in.LoadBalancerBackendPort))
+	// WARNING: in.APIServerStackType requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-func autoConvert_v1alpha3_ServiceAccount_To_v1alpha4_ServiceAccount(in *ServiceAccount, out *v1alpha4.ServiceAccount, s conversion.Scope) error {
+func autoConvert_v1alpha3_ServiceAccount_To_v1beta1_ServiceAccount(in *ServiceAccount, out *v1beta1.ServiceAccount, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
 	return nil
 }
 
-// Convert_v1alpha3_ServiceAccount_To_v1alpha4_ServiceAccount is an autogenerated conversion function.
-func Convert_v1alpha3_ServiceAccount_To_v1alpha4_ServiceAccount(in *ServiceAccount, out *v1alpha4.ServiceAccount, s conversion.Scope) error {
-	return autoConvert_v1alpha3_ServiceAccount_To_v1alpha4_ServiceAccount(in, out, s)
+// Convert_v1alpha3_ServiceAccount_To_v1beta1_ServiceAccount is an autogenerated conversion function.
+func Convert_v1alpha3_ServiceAccount_To_v1beta1_ServiceAccount(in *ServiceAccount, out *v1beta1.ServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ServiceAccount_To_v1beta1_ServiceAccount(in, out, s)
 }
 
-func autoConvert_v1alpha4_ServiceAccount_To_v1alpha3_ServiceAccount(in *v1alpha4.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
+func autoConvert_v1beta1_ServiceAccount_To_v1alpha3_ServiceAccount(in *v1beta1.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
 	return nil
 }
 
-// Convert_v1alpha4_ServiceAccount_To_v1alpha3_ServiceAccount is an autogenerated conversion function.
-func Convert_v1alpha4_ServiceAccount_To_v1alpha3_ServiceAccount(in *v1alpha4.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
-	return autoConvert_v1alpha4_ServiceAccount_To_v1alpha3_ServiceAccount(in, out, s)
+// Convert_v1beta1_ServiceAccount_To_v1alpha3_ServiceAccount is an autogenerated conversion function.
+func Convert_v1beta1_ServiceAccount_To_v1alpha3_ServiceAccount(in *v1beta1.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceAccount_To_v1alpha3_ServiceAccount(in, out, s)
 }
 
-func autoConvert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(in *SubnetSpec, out *v1alpha4.SubnetSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec(in *SubnetSpec, out *v1beta1.SubnetSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.CidrBlock = in.CidrBlock
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -795,12 +870,12 @@ func autoConvert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(in *SubnetSpec, out
 	return nil
 }
 
-// Convert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec is an autogenerated conversion function.
-func Convert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(in *SubnetSpec, out *v1alpha4.SubnetSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_SubnetSpec_To_v1alpha4_SubnetSpec(in, out, s)
+// Convert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec(in *SubnetSpec, out *v1beta1.SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
 }
 
-func autoConvert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(in *v1alpha4.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
+func autoConvert_v1beta1_SubnetSpec_To_v1alpha3_SubnetSpec(in *v1beta1.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.CidrBlock = in.CidrBlock
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -808,10 +883,7 @@ func autoConvert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(in *v1alpha4.SubnetS
 	out.Region = in.Region
 	out.PrivateGoogleAccess = (*bool)(unsafe.Pointer(in.PrivateGoogleAccess))
 	out.EnableFlowLogs = (*bool)(unsafe.Pointer(in.EnableFlowLogs))
+	// WARNING: in.StackType requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPv6AccessType requires manual conversion: does not exist in peer-type
 	return nil
 }
-
-// Convert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec is an autogenerated conversion function.
-func Convert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(in *v1alpha4.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha4_SubnetSpec_To_v1alpha3_SubnetSpec(in, out, s)
-}
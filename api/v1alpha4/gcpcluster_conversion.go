@@ -16,8 +16,54 @@ limitations under the License.
 
 package v1alpha4
 
-// Hub marks GCPCluster as a conversion hub.
-func (*GCPCluster) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks GCPClusterList as a conversion hub.
-func (*GCPClusterList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+// ConvertTo converts this GCPCluster to the Hub version (v1beta1).
+func (src *GCPCluster) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPCluster)
+
+	if err := Convert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Drop the Hub data preserved on down-conversion. v1alpha4 doesn't miss any field yet.
+	restored := &infrav1.GCPCluster{}
+	if _, err := utilconversion.UnmarshalData(src, restored); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPCluster) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPCluster)
+
+	if err := Convert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this GCPClusterList to the Hub version (v1beta1).
+func (src *GCPClusterList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPClusterList)
+	return Convert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPClusterList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPClusterList)
+	return Convert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList(src, dst, nil)
+}
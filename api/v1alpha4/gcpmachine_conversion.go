@@ -16,8 +16,54 @@ limitations under the License.
 
 package v1alpha4
 
-// Hub marks GCPMachine as a conversion hub.
-func (*GCPMachine) Hub() {}
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks GCPMachineList as a conversion hub.
-func (*GCPMachineList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+// ConvertTo converts this GCPMachine to the Hub version (v1beta1).
+func (src *GCPMachine) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPMachine)
+
+	if err := Convert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Drop the Hub data preserved on down-conversion. v1alpha4 doesn't miss any field yet.
+	restored := &infrav1.GCPMachine{}
+	if _, err := utilconversion.UnmarshalData(src, restored); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachine) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPMachine)
+
+	if err := Convert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this GCPMachineList to the Hub version (v1beta1).
+func (src *GCPMachineList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPMachineList)
+	return Convert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachineList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPMachineList)
+	return Convert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList(src, dst, nil)
+}
@@ -16,8 +16,64 @@ limitations under the License.
 
 package v1alpha4
 
-// Hub marks GCPMachineTemplate as a conversion hub.
-func (*GCPMachineTemplate) Hub() {}
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
-// Hub marks GCPMachineTemplateList as a conversion hub.
-func (*GCPMachineTemplateList) Hub() {}
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+// ConvertTo converts this GCPMachineTemplate to the Hub version (v1beta1).
+func (src *GCPMachineTemplate) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPMachineTemplate)
+
+	if err := Convert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Restore the status, which doesn't exist in v1alpha4, from the Hub data preserved on
+	// down-conversion. Only the status is restored: v1alpha4 doesn't miss any spec field.
+	// Clients reading the capacity use v1beta1, as advertised by the contract label.
+	restored := &infrav1.GCPMachineTemplate{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Status = restored.Status
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPMachineTemplate)
+
+	if err := Convert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	if err := utilconversion.MarshalData(src, dst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConvertTo converts this GCPMachineTemplateList to the Hub version (v1beta1).
+func (src *GCPMachineTemplateList) ConvertTo(dstRaw conversion.Hub) error { // nolint
+	dst := dstRaw.(*infrav1.GCPMachineTemplateList)
+	return Convert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachineTemplateList) ConvertFrom(srcRaw conversion.Hub) error { // nolint
+	src := srcRaw.(*infrav1.GCPMachineTemplateList)
+	return Convert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(src, dst, nil)
+}
+
+// Convert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate converts from the Hub version (v1beta1) of the GCPMachineTemplate to this version.
+func Convert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in *infrav1.GCPMachineTemplate, out *GCPMachineTemplate, s apiconversion.Scope) error { // nolint
+	return autoConvert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in, out, s)
+}
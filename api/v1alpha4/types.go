@@ -18,10 +18,17 @@ package v1alpha4
 
 import (
 	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
 )
 
 // GCPMachineTemplateResource describes the data needed to create am GCPMachine from a template.
 type GCPMachineTemplateResource struct {
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	ObjectMeta clusterv1.ObjectMeta `json:"metadata,omitempty"`
+
 	// Spec is the specification of the desired behavior of the machine.
 	Spec GCPMachineSpec `json:"spec"`
 }
@@ -54,6 +61,10 @@ type Network struct {
 	// +optional
 	APIServerAddress *string `json:"apiServerIpAddress,omitempty"`
 
+	// APIServerIP is the IP address of the load balancer created for the API Server.
+	// +optional
+	APIServerIP *string `json:"apiServerIP,omitempty"`
+
 	// APIServerHealthCheck is the full reference to the health check
 	// created for the API Server.
 	// +optional
@@ -78,11 +89,59 @@ type Network struct {
 	// created for the API Server.
 	// +optional
 	APIServerForwardingRule *string `json:"apiServerForwardingRule,omitempty"`
+
+	// APIServerIPv6Address is the IPV6 global address assigned to the load balancer
+	// created for the API Server, when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6Address *string `json:"apiServerIPv6Address,omitempty"`
+
+	// APIServerIPv6 is the IPv6 address of the load balancer created for the API Server,
+	// when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6 *string `json:"apiServerIPv6,omitempty"`
+
+	// APIServerIPv6ForwardingRule is the full reference to the IPV6 forwarding rule
+	// created for the API Server, when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6ForwardingRule *string `json:"apiServerIPv6ForwardingRule,omitempty"`
 }
 
+// StackType is the IP stack of a subnetwork, network interface or load balancer frontend.
+// +kubebuilder:validation:Enum=IPV4_ONLY;IPV4_IPV6
+type StackType string
+
+const (
+	// StackTypeIPv4Only is the IPv4 single stack.
+	StackTypeIPv4Only = StackType("IPV4_ONLY")
+	// StackTypeIPv4IPv6 is the IPv4 and IPv6 dual stack.
+	StackTypeIPv4IPv6 = StackType("IPV4_IPV6")
+)
+
+// IPv6AccessType is the access type of the IPv6 addresses of a subnetwork.
+// +kubebuilder:validation:Enum=INTERNAL;EXTERNAL
+type IPv6AccessType string
+
+const (
+	// IPv6AccessTypeInternal allocates IPv6 addresses only reachable within the VPC network.
+	IPv6AccessTypeInternal = IPv6AccessType("INTERNAL")
+	// IPv6AccessTypeExternal allocates IPv6 addresses reachable from the internet.
+	IPv6AccessTypeExternal = IPv6AccessType("EXTERNAL")
+)
+
+// NetworkTier is the network tier of external IP addresses.
+// +kubebuilder:validation:Enum=Premium;Standard
+type NetworkTier string
+
+const (
+	// NetworkTierPremium is the Premium network tier, using Google's global network.
+	NetworkTierPremium = NetworkTier("Premium")
+	// NetworkTierStandard is the Standard network tier, using regional peering with the internet.
+	NetworkTierStandard = NetworkTier("Standard")
+)
+
 // NetworkSpec encapsulates all things related to a GCP network.
 type NetworkSpec struct {
-	// Name is the name of the network to be used.
+	// Name is the name of the network to be used. Defaults to "default".
 	// +optional
 	Name *string `json:"name,omitempty"`
 
@@ -105,6 +164,12 @@ type NetworkSpec struct {
 	// Allow for configuration of load balancer backend (useful for changing apiserver port)
 	// +optional
 	LoadBalancerBackendPort *int32 `json:"loadBalancerBackendPort,omitempty"`
+
+	// APIServerStackType is the IP stack of the API server load balancer frontend. With IPV4_IPV6
+	// an additional IPv6 address and forwarding rule are created, which requires the Premium network tier.
+	// Defaults to IPV4_ONLY.
+	// +optional
+	APIServerStackType *StackType `json:"apiServerStackType,omitempty"`
 }
 
 // SubnetSpec configures an GCP Subnet.
@@ -115,8 +180,8 @@ type SubnetSpec struct {
 	// CidrBlock is the range of internal addresses that are owned by this
 	// subnetwork. Provide this property when you create the subnetwork. For
 	// example, 10.0.0.0/8 or 192.168.0.0/16. Ranges must be unique and
-	// non-overlapping within a network. Only IPv4 is supported. This field
-	// can be set only at resource creation time.
+	// non-overlapping within a network. This field can be set only at
+	// resource creation time.
 	CidrBlock string `json:"cidrBlock,omitempty"`
 
 	// Description is an optional description associated with the resource.
@@ -141,6 +206,16 @@ type SubnetSpec struct {
 	// listings. If not set the default behavior is to disable flow logging.
 	// +optional
 	EnableFlowLogs *bool `json:"routeTableId"`
+
+	// StackType is the IP stack of the subnetwork. IPv6 ranges are allocated by GCP.
+	// Defaults to IPV4_ONLY.
+	// +optional
+	StackType *StackType `json:"stackType,omitempty"`
+
+	// IPv6AccessType is the access type of the IPv6 addresses of a dual-stack subnetwork.
+	// Required when StackType is IPV4_IPV6.
+	// +optional
+	IPv6AccessType *IPv6AccessType `json:"ipv6AccessType,omitempty"`
 }
 
 // String returns a string representation of the subnet.
@@ -0,0 +1,1606 @@
+// +build !ignore_autogenerated_core_v1alpha4
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen-v0.20.2. DO NOT EDIT.
+
+package v1alpha4
+
+import (
+	unsafe "unsafe"
+
+	v1 "k8s.io/api/core/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	apiv1alpha4 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	errors "sigs.k8s.io/cluster-api/errors"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AddressReservation)(nil), (*v1beta1.AddressReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_AddressReservation_To_v1beta1_AddressReservation(a.(*AddressReservation), b.(*v1beta1.AddressReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.AddressReservation)(nil), (*AddressReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AddressReservation_To_v1alpha4_AddressReservation(a.(*v1beta1.AddressReservation), b.(*AddressReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddressReservations)(nil), (*v1beta1.AddressReservations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_AddressReservations_To_v1beta1_AddressReservations(a.(*AddressReservations), b.(*v1beta1.AddressReservations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.AddressReservations)(nil), (*AddressReservations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AddressReservations_To_v1alpha4_AddressReservations(a.(*v1beta1.AddressReservations), b.(*AddressReservations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdvancedMachineFeatures)(nil), (*v1beta1.AdvancedMachineFeatures)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_AdvancedMachineFeatures_To_v1beta1_AdvancedMachineFeatures(a.(*AdvancedMachineFeatures), b.(*v1beta1.AdvancedMachineFeatures), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.AdvancedMachineFeatures)(nil), (*AdvancedMachineFeatures)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AdvancedMachineFeatures_To_v1alpha4_AdvancedMachineFeatures(a.(*v1beta1.AdvancedMachineFeatures), b.(*AdvancedMachineFeatures), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AliasIPRange)(nil), (*v1beta1.AliasIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_AliasIPRange_To_v1beta1_AliasIPRange(a.(*AliasIPRange), b.(*v1beta1.AliasIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.AliasIPRange)(nil), (*AliasIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AliasIPRange_To_v1alpha4_AliasIPRange(a.(*v1beta1.AliasIPRange), b.(*AliasIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AttachedDiskSpec)(nil), (*v1beta1.AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(a.(*AttachedDiskSpec), b.(*v1beta1.AttachedDiskSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.AttachedDiskSpec)(nil), (*AttachedDiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(a.(*v1beta1.AttachedDiskSpec), b.(*AttachedDiskSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*v1beta1.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_BastionSpec_To_v1beta1_BastionSpec(a.(*BastionSpec), b.(*v1beta1.BastionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.BastionSpec)(nil), (*BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BastionSpec_To_v1alpha4_BastionSpec(a.(*v1beta1.BastionSpec), b.(*BastionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionStatus)(nil), (*v1beta1.BastionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_BastionStatus_To_v1beta1_BastionStatus(a.(*BastionStatus), b.(*v1beta1.BastionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.BastionStatus)(nil), (*BastionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BastionStatus_To_v1alpha4_BastionStatus(a.(*v1beta1.BastionStatus), b.(*BastionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BuildParams)(nil), (*v1beta1.BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_BuildParams_To_v1beta1_BuildParams(a.(*BuildParams), b.(*v1beta1.BuildParams), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.BuildParams)(nil), (*BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BuildParams_To_v1alpha4_BuildParams(a.(*v1beta1.BuildParams), b.(*BuildParams), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneAccessSpec)(nil), (*v1beta1.ControlPlaneAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_ControlPlaneAccessSpec_To_v1beta1_ControlPlaneAccessSpec(a.(*ControlPlaneAccessSpec), b.(*v1beta1.ControlPlaneAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ControlPlaneAccessSpec)(nil), (*ControlPlaneAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneAccessSpec_To_v1alpha4_ControlPlaneAccessSpec(a.(*v1beta1.ControlPlaneAccessSpec), b.(*ControlPlaneAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomMachineType)(nil), (*v1beta1.CustomMachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_CustomMachineType_To_v1beta1_CustomMachineType(a.(*CustomMachineType), b.(*v1beta1.CustomMachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.CustomMachineType)(nil), (*CustomMachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CustomMachineType_To_v1alpha4_CustomMachineType(a.(*v1beta1.CustomMachineType), b.(*CustomMachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSSpec)(nil), (*v1beta1.DNSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_DNSSpec_To_v1beta1_DNSSpec(a.(*DNSSpec), b.(*v1beta1.DNSSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.DNSSpec)(nil), (*DNSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSSpec_To_v1alpha4_DNSSpec(a.(*v1beta1.DNSSpec), b.(*DNSSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Filter)(nil), (*v1beta1.Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_Filter_To_v1beta1_Filter(a.(*Filter), b.(*v1beta1.Filter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Filter)(nil), (*Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Filter_To_v1alpha4_Filter(a.(*v1beta1.Filter), b.(*Filter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPCluster)(nil), (*v1beta1.GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster(a.(*GCPCluster), b.(*v1beta1.GCPCluster), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPCluster)(nil), (*GCPCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster(a.(*v1beta1.GCPCluster), b.(*GCPCluster), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterList)(nil), (*v1beta1.GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList(a.(*GCPClusterList), b.(*v1beta1.GCPClusterList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterList)(nil), (*GCPClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList(a.(*v1beta1.GCPClusterList), b.(*GCPClusterList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterSpec)(nil), (*v1beta1.GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(a.(*GCPClusterSpec), b.(*v1beta1.GCPClusterSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterSpec)(nil), (*GCPClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(a.(*v1beta1.GCPClusterSpec), b.(*GCPClusterSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterStatus)(nil), (*v1beta1.GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus(a.(*GCPClusterStatus), b.(*v1beta1.GCPClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterStatus)(nil), (*GCPClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(a.(*v1beta1.GCPClusterStatus), b.(*GCPClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterTemplate)(nil), (*v1beta1.GCPClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate(a.(*GCPClusterTemplate), b.(*v1beta1.GCPClusterTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterTemplate)(nil), (*GCPClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterTemplate_To_v1alpha4_GCPClusterTemplate(a.(*v1beta1.GCPClusterTemplate), b.(*GCPClusterTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterTemplateList)(nil), (*v1beta1.GCPClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList(a.(*GCPClusterTemplateList), b.(*v1beta1.GCPClusterTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterTemplateList)(nil), (*GCPClusterTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterTemplateList_To_v1alpha4_GCPClusterTemplateList(a.(*v1beta1.GCPClusterTemplateList), b.(*GCPClusterTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterTemplateResource)(nil), (*v1beta1.GCPClusterTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource(a.(*GCPClusterTemplateResource), b.(*v1beta1.GCPClusterTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterTemplateResource)(nil), (*GCPClusterTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource(a.(*v1beta1.GCPClusterTemplateResource), b.(*GCPClusterTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPClusterTemplateSpec)(nil), (*v1beta1.GCPClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec(a.(*GCPClusterTemplateSpec), b.(*v1beta1.GCPClusterTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPClusterTemplateSpec)(nil), (*GCPClusterTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec(a.(*v1beta1.GCPClusterTemplateSpec), b.(*GCPClusterTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachine)(nil), (*v1beta1.GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine(a.(*GCPMachine), b.(*v1beta1.GCPMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachine)(nil), (*GCPMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine(a.(*v1beta1.GCPMachine), b.(*GCPMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineList)(nil), (*v1beta1.GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList(a.(*GCPMachineList), b.(*v1beta1.GCPMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineList)(nil), (*GCPMachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList(a.(*v1beta1.GCPMachineList), b.(*GCPMachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineSpec)(nil), (*v1beta1.GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(a.(*GCPMachineSpec), b.(*v1beta1.GCPMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineSpec)(nil), (*GCPMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(a.(*v1beta1.GCPMachineSpec), b.(*GCPMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineStatus)(nil), (*v1beta1.GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus(a.(*GCPMachineStatus), b.(*v1beta1.GCPMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineStatus)(nil), (*GCPMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(a.(*v1beta1.GCPMachineStatus), b.(*GCPMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplate)(nil), (*v1beta1.GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(a.(*GCPMachineTemplate), b.(*v1beta1.GCPMachineTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateList)(nil), (*v1beta1.GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(a.(*GCPMachineTemplateList), b.(*v1beta1.GCPMachineTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineTemplateList)(nil), (*GCPMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(a.(*v1beta1.GCPMachineTemplateList), b.(*GCPMachineTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateResource)(nil), (*v1beta1.GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(a.(*GCPMachineTemplateResource), b.(*v1beta1.GCPMachineTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineTemplateResource)(nil), (*GCPMachineTemplateResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(a.(*v1beta1.GCPMachineTemplateResource), b.(*GCPMachineTemplateResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPMachineTemplateSpec)(nil), (*v1beta1.GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(a.(*GCPMachineTemplateSpec), b.(*v1beta1.GCPMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GCPMachineTemplateSpec)(nil), (*GCPMachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(a.(*v1beta1.GCPMachineTemplateSpec), b.(*GCPMachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GuestAccelerator)(nil), (*v1beta1.GuestAccelerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_GuestAccelerator_To_v1beta1_GuestAccelerator(a.(*GuestAccelerator), b.(*v1beta1.GuestAccelerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.GuestAccelerator)(nil), (*GuestAccelerator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GuestAccelerator_To_v1alpha4_GuestAccelerator(a.(*v1beta1.GuestAccelerator), b.(*GuestAccelerator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageLookup)(nil), (*v1beta1.ImageLookup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_ImageLookup_To_v1beta1_ImageLookup(a.(*ImageLookup), b.(*v1beta1.ImageLookup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ImageLookup)(nil), (*ImageLookup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImageLookup_To_v1alpha4_ImageLookup(a.(*v1beta1.ImageLookup), b.(*ImageLookup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerSpec)(nil), (*v1beta1.LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(a.(*LoadBalancerSpec), b.(*v1beta1.LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.LoadBalancerSpec)(nil), (*LoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoadBalancerSpec_To_v1alpha4_LoadBalancerSpec(a.(*v1beta1.LoadBalancerSpec), b.(*LoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetadataItem)(nil), (*v1beta1.MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_MetadataItem_To_v1beta1_MetadataItem(a.(*MetadataItem), b.(*v1beta1.MetadataItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.MetadataItem)(nil), (*MetadataItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetadataItem_To_v1alpha4_MetadataItem(a.(*v1beta1.MetadataItem), b.(*MetadataItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Network)(nil), (*v1beta1.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_Network_To_v1beta1_Network(a.(*Network), b.(*v1beta1.Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Network_To_v1alpha4_Network(a.(*v1beta1.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterface)(nil), (*v1beta1.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_NetworkInterface_To_v1beta1_NetworkInterface(a.(*NetworkInterface), b.(*v1beta1.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkInterface_To_v1alpha4_NetworkInterface(a.(*v1beta1.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkSpec)(nil), (*v1beta1.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec(a.(*NetworkSpec), b.(*v1beta1.NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec(a.(*v1beta1.NetworkSpec), b.(*NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeAffinity)(nil), (*v1beta1.NodeAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_NodeAffinity_To_v1beta1_NodeAffinity(a.(*NodeAffinity), b.(*v1beta1.NodeAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.NodeAffinity)(nil), (*NodeAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeAffinity_To_v1alpha4_NodeAffinity(a.(*v1beta1.NodeAffinity), b.(*NodeAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlacementPolicySpec)(nil), (*v1beta1.PlacementPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_PlacementPolicySpec_To_v1beta1_PlacementPolicySpec(a.(*PlacementPolicySpec), b.(*v1beta1.PlacementPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.PlacementPolicySpec)(nil), (*PlacementPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PlacementPolicySpec_To_v1alpha4_PlacementPolicySpec(a.(*v1beta1.PlacementPolicySpec), b.(*PlacementPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReservationAffinity)(nil), (*v1beta1.ReservationAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_ReservationAffinity_To_v1beta1_ReservationAffinity(a.(*ReservationAffinity), b.(*v1beta1.ReservationAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ReservationAffinity)(nil), (*ReservationAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ReservationAffinity_To_v1alpha4_ReservationAffinity(a.(*v1beta1.ReservationAffinity), b.(*ReservationAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*v1beta1.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_ServiceAccount_To_v1beta1_ServiceAccount(a.(*ServiceAccount), b.(*v1beta1.ServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.ServiceAccount)(nil), (*ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceAccount_To_v1alpha4_ServiceAccount(a.(*v1beta1.ServiceAccount), b.(*ServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SubnetSpec)(nil), (*v1beta1.SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha4_SubnetSpec_To_v1beta1_SubnetSpec(a.(*SubnetSpec), b.(*v1beta1.SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1beta1.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(a.(*v1beta1.SubnetSpec), b.(*SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.GCPMachineTemplate)(nil), (*GCPMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(a.(*v1beta1.GCPMachineTemplate), b.(*GCPMachineTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha4_AddressReservation_To_v1beta1_AddressReservation(in *AddressReservation, out *v1beta1.AddressReservation, s conversion.Scope) error {
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	out.Preserve = in.Preserve
+	return nil
+}
+
+// Convert_v1alpha4_AddressReservation_To_v1beta1_AddressReservation is an autogenerated conversion function.
+func Convert_v1alpha4_AddressReservation_To_v1beta1_AddressReservation(in *AddressReservation, out *v1beta1.AddressReservation, s conversion.Scope) error {
+	return autoConvert_v1alpha4_AddressReservation_To_v1beta1_AddressReservation(in, out, s)
+}
+
+func autoConvert_v1beta1_AddressReservation_To_v1alpha4_AddressReservation(in *v1beta1.AddressReservation, out *AddressReservation, s conversion.Scope) error {
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	out.Preserve = in.Preserve
+	return nil
+}
+
+// Convert_v1beta1_AddressReservation_To_v1alpha4_AddressReservation is an autogenerated conversion function.
+func Convert_v1beta1_AddressReservation_To_v1alpha4_AddressReservation(in *v1beta1.AddressReservation, out *AddressReservation, s conversion.Scope) error {
+	return autoConvert_v1beta1_AddressReservation_To_v1alpha4_AddressReservation(in, out, s)
+}
+
+func autoConvert_v1alpha4_AddressReservations_To_v1beta1_AddressReservations(in *AddressReservations, out *v1beta1.AddressReservations, s conversion.Scope) error {
+	out.Internal = (*v1beta1.AddressReservation)(unsafe.Pointer(in.Internal))
+	out.External = (*v1beta1.AddressReservation)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_v1alpha4_AddressReservations_To_v1beta1_AddressReservations is an autogenerated conversion function.
+func Convert_v1alpha4_AddressReservations_To_v1beta1_AddressReservations(in *AddressReservations, out *v1beta1.AddressReservations, s conversion.Scope) error {
+	return autoConvert_v1alpha4_AddressReservations_To_v1beta1_AddressReservations(in, out, s)
+}
+
+func autoConvert_v1beta1_AddressReservations_To_v1alpha4_AddressReservations(in *v1beta1.AddressReservations, out *AddressReservations, s conversion.Scope) error {
+	out.Internal = (*AddressReservation)(unsafe.Pointer(in.Internal))
+	out.External = (*AddressReservation)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_v1beta1_AddressReservations_To_v1alpha4_AddressReservations is an autogenerated conversion function.
+func Convert_v1beta1_AddressReservations_To_v1alpha4_AddressReservations(in *v1beta1.AddressReservations, out *AddressReservations, s conversion.Scope) error {
+	return autoConvert_v1beta1_AddressReservations_To_v1alpha4_AddressReservations(in, out, s)
+}
+
+func autoConvert_v1alpha4_AdvancedMachineFeatures_To_v1beta1_AdvancedMachineFeatures(in *AdvancedMachineFeatures, out *v1beta1.AdvancedMachineFeatures, s conversion.Scope) error {
+	out.ThreadsPerCore = (*int64)(unsafe.Pointer(in.ThreadsPerCore))
+	out.EnableNestedVirtualization = in.EnableNestedVirtualization
+	return nil
+}
+
+// Convert_v1alpha4_AdvancedMachineFeatures_To_v1beta1_AdvancedMachineFeatures is an autogenerated conversion function.
+func Convert_v1alpha4_AdvancedMachineFeatures_To_v1beta1_AdvancedMachineFeatures(in *AdvancedMachineFeatures, out *v1beta1.AdvancedMachineFeatures, s conversion.Scope) error {
+	return autoConvert_v1alpha4_AdvancedMachineFeatures_To_v1beta1_AdvancedMachineFeatures(in, out, s)
+}
+
+func autoConvert_v1beta1_AdvancedMachineFeatures_To_v1alpha4_AdvancedMachineFeatures(in *v1beta1.AdvancedMachineFeatures, out *AdvancedMachineFeatures, s conversion.Scope) error {
+	out.ThreadsPerCore = (*int64)(unsafe.Pointer(in.ThreadsPerCore))
+	out.EnableNestedVirtualization = in.EnableNestedVirtualization
+	return nil
+}
+
+// Convert_v1beta1_AdvancedMachineFeatures_To_v1alpha4_AdvancedMachineFeatures is an autogenerated conversion function.
+func Convert_v1beta1_AdvancedMachineFeatures_To_v1alpha4_AdvancedMachineFeatures(in *v1beta1.AdvancedMachineFeatures, out *AdvancedMachineFeatures, s conversion.Scope) error {
+	return autoConvert_v1beta1_AdvancedMachineFeatures_To_v1alpha4_AdvancedMachineFeatures(in, out, s)
+}
+
+func autoConvert_v1alpha4_AliasIPRange_To_v1beta1_AliasIPRange(in *AliasIPRange, out *v1beta1.AliasIPRange, s conversion.Scope) error {
+	out.IPCidrRange = in.IPCidrRange
+	out.SubnetworkRangeName = (*string)(unsafe.Pointer(in.SubnetworkRangeName))
+	return nil
+}
+
+// Convert_v1alpha4_AliasIPRange_To_v1beta1_AliasIPRange is an autogenerated conversion function.
+func Convert_v1alpha4_AliasIPRange_To_v1beta1_AliasIPRange(in *AliasIPRange, out *v1beta1.AliasIPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha4_AliasIPRange_To_v1beta1_AliasIPRange(in, out, s)
+}
+
+func autoConvert_v1beta1_AliasIPRange_To_v1alpha4_AliasIPRange(in *v1beta1.AliasIPRange, out *AliasIPRange, s conversion.Scope) error {
+	out.IPCidrRange = in.IPCidrRange
+	out.SubnetworkRangeName = (*string)(unsafe.Pointer(in.SubnetworkRangeName))
+	return nil
+}
+
+// Convert_v1beta1_AliasIPRange_To_v1alpha4_AliasIPRange is an autogenerated conversion function.
+func Convert_v1beta1_AliasIPRange_To_v1alpha4_AliasIPRange(in *v1beta1.AliasIPRange, out *AliasIPRange, s conversion.Scope) error {
+	return autoConvert_v1beta1_AliasIPRange_To_v1alpha4_AliasIPRange(in, out, s)
+}
+
+func autoConvert_v1alpha4_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in *AttachedDiskSpec, out *v1beta1.AttachedDiskSpec, s conversion.Scope) error {
+	out.DeviceType = (*v1beta1.DiskType)(unsafe.Pointer(in.DeviceType))
+	out.Size = (*int64)(unsafe.Pointer(in.Size))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.SourceSnapshot = (*string)(unsafe.Pointer(in.SourceSnapshot))
+	out.SourceImage = (*string)(unsafe.Pointer(in.SourceImage))
+	out.Labels = *(*v1beta1.Labels)(unsafe.Pointer(&in.Labels))
+	out.AutoDelete = (*bool)(unsafe.Pointer(in.AutoDelete))
+	out.ResourcePolicies = *(*[]string)(unsafe.Pointer(&in.ResourcePolicies))
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	return nil
+}
+
+// Convert_v1alpha4_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec is an autogenerated conversion function.
+func Convert_v1alpha4_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in *AttachedDiskSpec, out *v1beta1.AttachedDiskSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_AttachedDiskSpec_To_v1beta1_AttachedDiskSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in *v1beta1.AttachedDiskSpec, out *AttachedDiskSpec, s conversion.Scope) error {
+	out.DeviceType = (*DiskType)(unsafe.Pointer(in.DeviceType))
+	out.Size = (*int64)(unsafe.Pointer(in.Size))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.SourceSnapshot = (*string)(unsafe.Pointer(in.SourceSnapshot))
+	out.SourceImage = (*string)(unsafe.Pointer(in.SourceImage))
+	out.Labels = *(*Labels)(unsafe.Pointer(&in.Labels))
+	out.AutoDelete = (*bool)(unsafe.Pointer(in.AutoDelete))
+	out.ResourcePolicies = *(*[]string)(unsafe.Pointer(&in.ResourcePolicies))
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	return nil
+}
+
+// Convert_v1beta1_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec is an autogenerated conversion function.
+func Convert_v1beta1_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in *v1beta1.AttachedDiskSpec, out *AttachedDiskSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_AttachedDiskSpec_To_v1alpha4_AttachedDiskSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_BastionSpec_To_v1beta1_BastionSpec(in *BastionSpec, out *v1beta1.BastionSpec, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.AccessMode = v1beta1.BastionAccessMode(in.AccessMode)
+	out.AllowedCIDRBlocks = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRBlocks))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	return nil
+}
+
+// Convert_v1alpha4_BastionSpec_To_v1beta1_BastionSpec is an autogenerated conversion function.
+func Convert_v1alpha4_BastionSpec_To_v1beta1_BastionSpec(in *BastionSpec, out *v1beta1.BastionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_BastionSpec_To_v1beta1_BastionSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_BastionSpec_To_v1alpha4_BastionSpec(in *v1beta1.BastionSpec, out *BastionSpec, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.AccessMode = BastionAccessMode(in.AccessMode)
+	out.AllowedCIDRBlocks = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRBlocks))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	return nil
+}
+
+// Convert_v1beta1_BastionSpec_To_v1alpha4_BastionSpec is an autogenerated conversion function.
+func Convert_v1beta1_BastionSpec_To_v1alpha4_BastionSpec(in *v1beta1.BastionSpec, out *BastionSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_BastionSpec_To_v1alpha4_BastionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_BastionStatus_To_v1beta1_BastionStatus(in *BastionStatus, out *v1beta1.BastionStatus, s conversion.Scope) error {
+	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
+	out.Zone = in.Zone
+	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1alpha4_BastionStatus_To_v1beta1_BastionStatus is an autogenerated conversion function.
+func Convert_v1alpha4_BastionStatus_To_v1beta1_BastionStatus(in *BastionStatus, out *v1beta1.BastionStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha4_BastionStatus_To_v1beta1_BastionStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_BastionStatus_To_v1alpha4_BastionStatus(in *v1beta1.BastionStatus, out *BastionStatus, s conversion.Scope) error {
+	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
+	out.Zone = in.Zone
+	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
+	return nil
+}
+
+// Convert_v1beta1_BastionStatus_To_v1alpha4_BastionStatus is an autogenerated conversion function.
+func Convert_v1beta1_BastionStatus_To_v1alpha4_BastionStatus(in *v1beta1.BastionStatus, out *BastionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BastionStatus_To_v1alpha4_BastionStatus(in, out, s)
+}
+
+func autoConvert_v1alpha4_BuildParams_To_v1beta1_BuildParams(in *BuildParams, out *v1beta1.BuildParams, s conversion.Scope) error {
+	out.Lifecycle = v1beta1.ResourceLifecycle(in.Lifecycle)
+	out.ClusterName = in.ClusterName
+	out.ResourceID = in.ResourceID
+	out.Role = (*string)(unsafe.Pointer(in.Role))
+	out.Additional = *(*v1beta1.Labels)(unsafe.Pointer(&in.Additional))
+	return nil
+}
+
+// Convert_v1alpha4_BuildParams_To_v1beta1_BuildParams is an autogenerated conversion function.
+func Convert_v1alpha4_BuildParams_To_v1beta1_BuildParams(in *BuildParams, out *v1beta1.BuildParams, s conversion.Scope) error {
+	return autoConvert_v1alpha4_BuildParams_To_v1beta1_BuildParams(in, out, s)
+}
+
+func autoConvert_v1beta1_BuildParams_To_v1alpha4_BuildParams(in *v1beta1.BuildParams, out *BuildParams, s conversion.Scope) error {
+	out.Lifecycle = ResourceLifecycle(in.Lifecycle)
+	out.ClusterName = in.ClusterName
+	out.ResourceID = in.ResourceID
+	out.Role = (*string)(unsafe.Pointer(in.Role))
+	out.Additional = *(*Labels)(unsafe.Pointer(&in.Additional))
+	return nil
+}
+
+// Convert_v1beta1_BuildParams_To_v1alpha4_BuildParams is an autogenerated conversion function.
+func Convert_v1beta1_BuildParams_To_v1alpha4_BuildParams(in *v1beta1.BuildParams, out *BuildParams, s conversion.Scope) error {
+	return autoConvert_v1beta1_BuildParams_To_v1alpha4_BuildParams(in, out, s)
+}
+
+func autoConvert_v1alpha4_ControlPlaneAccessSpec_To_v1beta1_ControlPlaneAccessSpec(in *ControlPlaneAccessSpec, out *v1beta1.ControlPlaneAccessSpec, s conversion.Scope) error {
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
+	return nil
+}
+
+// Convert_v1alpha4_ControlPlaneAccessSpec_To_v1beta1_ControlPlaneAccessSpec is an autogenerated conversion function.
+func Convert_v1alpha4_ControlPlaneAccessSpec_To_v1beta1_ControlPlaneAccessSpec(in *ControlPlaneAccessSpec, out *v1beta1.ControlPlaneAccessSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_ControlPlaneAccessSpec_To_v1beta1_ControlPlaneAccessSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlaneAccessSpec_To_v1alpha4_ControlPlaneAccessSpec(in *v1beta1.ControlPlaneAccessSpec, out *ControlPlaneAccessSpec, s conversion.Scope) error {
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneAccessSpec_To_v1alpha4_ControlPlaneAccessSpec is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneAccessSpec_To_v1alpha4_ControlPlaneAccessSpec(in *v1beta1.ControlPlaneAccessSpec, out *ControlPlaneAccessSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneAccessSpec_To_v1alpha4_ControlPlaneAccessSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_CustomMachineType_To_v1beta1_CustomMachineType(in *CustomMachineType, out *v1beta1.CustomMachineType, s conversion.Scope) error {
+	out.Series = v1beta1.CustomMachineTypeSeries(in.Series)
+	out.CPUs = in.CPUs
+	out.MemoryMB = in.MemoryMB
+	out.ExtendedMemory = in.ExtendedMemory
+	return nil
+}
+
+// Convert_v1alpha4_CustomMachineType_To_v1beta1_CustomMachineType is an autogenerated conversion function.
+func Convert_v1alpha4_CustomMachineType_To_v1beta1_CustomMachineType(in *CustomMachineType, out *v1beta1.CustomMachineType, s conversion.Scope) error {
+	return autoConvert_v1alpha4_CustomMachineType_To_v1beta1_CustomMachineType(in, out, s)
+}
+
+func autoConvert_v1beta1_CustomMachineType_To_v1alpha4_CustomMachineType(in *v1beta1.CustomMachineType, out *CustomMachineType, s conversion.Scope) error {
+	out.Series = CustomMachineTypeSeries(in.Series)
+	out.CPUs = in.CPUs
+	out.MemoryMB = in.MemoryMB
+	out.ExtendedMemory = in.ExtendedMemory
+	return nil
+}
+
+// Convert_v1beta1_CustomMachineType_To_v1alpha4_CustomMachineType is an autogenerated conversion function.
+func Convert_v1beta1_CustomMachineType_To_v1alpha4_CustomMachineType(in *v1beta1.CustomMachineType, out *CustomMachineType, s conversion.Scope) error {
+	return autoConvert_v1beta1_CustomMachineType_To_v1alpha4_CustomMachineType(in, out, s)
+}
+
+func autoConvert_v1alpha4_DNSSpec_To_v1beta1_DNSSpec(in *DNSSpec, out *v1beta1.DNSSpec, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.ManagedZone = (*string)(unsafe.Pointer(in.ManagedZone))
+	out.Visibility = v1beta1.DNSZoneVisibility(in.Visibility)
+	out.APIServerRecordName = (*string)(unsafe.Pointer(in.APIServerRecordName))
+	return nil
+}
+
+// Convert_v1alpha4_DNSSpec_To_v1beta1_DNSSpec is an autogenerated conversion function.
+func Convert_v1alpha4_DNSSpec_To_v1beta1_DNSSpec(in *DNSSpec, out *v1beta1.DNSSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_DNSSpec_To_v1beta1_DNSSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_DNSSpec_To_v1alpha4_DNSSpec(in *v1beta1.DNSSpec, out *DNSSpec, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.ManagedZone = (*string)(unsafe.Pointer(in.ManagedZone))
+	out.Visibility = DNSZoneVisibility(in.Visibility)
+	out.APIServerRecordName = (*string)(unsafe.Pointer(in.APIServerRecordName))
+	return nil
+}
+
+// Convert_v1beta1_DNSSpec_To_v1alpha4_DNSSpec is an autogenerated conversion function.
+func Convert_v1beta1_DNSSpec_To_v1alpha4_DNSSpec(in *v1beta1.DNSSpec, out *DNSSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSSpec_To_v1alpha4_DNSSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_Filter_To_v1beta1_Filter(in *Filter, out *v1beta1.Filter, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha4_Filter_To_v1beta1_Filter is an autogenerated conversion function.
+func Convert_v1alpha4_Filter_To_v1beta1_Filter(in *Filter, out *v1beta1.Filter, s conversion.Scope) error {
+	return autoConvert_v1alpha4_Filter_To_v1beta1_Filter(in, out, s)
+}
+
+func autoConvert_v1beta1_Filter_To_v1alpha4_Filter(in *v1beta1.Filter, out *Filter, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1beta1_Filter_To_v1alpha4_Filter is an autogenerated conversion function.
+func Convert_v1beta1_Filter_To_v1alpha4_Filter(in *v1beta1.Filter, out *Filter, s conversion.Scope) error {
+	return autoConvert_v1beta1_Filter_To_v1alpha4_Filter(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster(in *GCPCluster, out *v1beta1.GCPCluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster is an autogenerated conversion function.
+func Convert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster(in *GCPCluster, out *v1beta1.GCPCluster, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPCluster_To_v1beta1_GCPCluster(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster(in *v1beta1.GCPCluster, out *GCPCluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster is an autogenerated conversion function.
+func Convert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster(in *v1beta1.GCPCluster, out *GCPCluster, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPCluster_To_v1alpha4_GCPCluster(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList(in *GCPClusterList, out *v1beta1.GCPClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1beta1.GCPCluster)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList(in *GCPClusterList, out *v1beta1.GCPClusterList, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterList_To_v1beta1_GCPClusterList(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList(in *v1beta1.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GCPCluster)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList(in *v1beta1.GCPClusterList, out *GCPClusterList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterList_To_v1alpha4_GCPClusterList(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in *GCPClusterSpec, out *v1beta1.GCPClusterSpec, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Region = in.Region
+	out.ResourceNamePrefix = (*string)(unsafe.Pointer(in.ResourceNamePrefix))
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if err := Convert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.NetworkTier = (*v1beta1.NetworkTier)(unsafe.Pointer(in.NetworkTier))
+	out.LoadBalancer = (*v1beta1.LoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
+	out.ControlPlaneAccess = (*v1beta1.ControlPlaneAccessSpec)(unsafe.Pointer(in.ControlPlaneAccess))
+	out.FailureDomains = *(*[]string)(unsafe.Pointer(&in.FailureDomains))
+	out.AdditionalLabels = *(*v1beta1.Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.PlacementPolicies = *(*[]v1beta1.PlacementPolicySpec)(unsafe.Pointer(&in.PlacementPolicies))
+	out.Bastion = (*v1beta1.BastionSpec)(unsafe.Pointer(in.Bastion))
+	out.DNS = (*v1beta1.DNSSpec)(unsafe.Pointer(in.DNS))
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in *GCPClusterSpec, out *v1beta1.GCPClusterSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in *v1beta1.GCPClusterSpec, out *GCPClusterSpec, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Region = in.Region
+	out.ResourceNamePrefix = (*string)(unsafe.Pointer(in.ResourceNamePrefix))
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if err := Convert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.NetworkTier = (*NetworkTier)(unsafe.Pointer(in.NetworkTier))
+	out.LoadBalancer = (*LoadBalancerSpec)(unsafe.Pointer(in.LoadBalancer))
+	out.ControlPlaneAccess = (*ControlPlaneAccessSpec)(unsafe.Pointer(in.ControlPlaneAccess))
+	out.FailureDomains = *(*[]string)(unsafe.Pointer(&in.FailureDomains))
+	out.AdditionalLabels = *(*Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.PlacementPolicies = *(*[]PlacementPolicySpec)(unsafe.Pointer(&in.PlacementPolicies))
+	out.Bastion = (*BastionSpec)(unsafe.Pointer(in.Bastion))
+	out.DNS = (*DNSSpec)(unsafe.Pointer(in.DNS))
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in *v1beta1.GCPClusterSpec, out *GCPClusterSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in *GCPClusterStatus, out *v1beta1.GCPClusterStatus, s conversion.Scope) error {
+	out.FailureDomains = *(*apiv1alpha4.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
+	if err := Convert_v1alpha4_Network_To_v1beta1_Network(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.Bastion = (*v1beta1.BastionStatus)(unsafe.Pointer(in.Bastion))
+	out.Ready = in.Ready
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in *GCPClusterStatus, out *v1beta1.GCPClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterStatus_To_v1beta1_GCPClusterStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in *v1beta1.GCPClusterStatus, out *GCPClusterStatus, s conversion.Scope) error {
+	out.FailureDomains = *(*apiv1alpha4.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
+	if err := Convert_v1beta1_Network_To_v1alpha4_Network(&in.Network, &out.Network, s); err != nil {
+		return err
+	}
+	out.Bastion = (*BastionStatus)(unsafe.Pointer(in.Bastion))
+	out.Ready = in.Ready
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in *v1beta1.GCPClusterStatus, out *GCPClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterStatus_To_v1alpha4_GCPClusterStatus(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate(in *GCPClusterTemplate, out *v1beta1.GCPClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate(in *GCPClusterTemplate, out *v1beta1.GCPClusterTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterTemplate_To_v1alpha4_GCPClusterTemplate(in *v1beta1.GCPClusterTemplate, out *GCPClusterTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterTemplate_To_v1alpha4_GCPClusterTemplate is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterTemplate_To_v1alpha4_GCPClusterTemplate(in *v1beta1.GCPClusterTemplate, out *GCPClusterTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterTemplate_To_v1alpha4_GCPClusterTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList(in *GCPClusterTemplateList, out *v1beta1.GCPClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1beta1.GCPClusterTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList(in *GCPClusterTemplateList, out *v1beta1.GCPClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterTemplateList_To_v1alpha4_GCPClusterTemplateList(in *v1beta1.GCPClusterTemplateList, out *GCPClusterTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GCPClusterTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterTemplateList_To_v1alpha4_GCPClusterTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterTemplateList_To_v1alpha4_GCPClusterTemplateList(in *v1beta1.GCPClusterTemplateList, out *GCPClusterTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterTemplateList_To_v1alpha4_GCPClusterTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource(in *GCPClusterTemplateResource, out *v1beta1.GCPClusterTemplateResource, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPClusterSpec_To_v1beta1_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource(in *GCPClusterTemplateResource, out *v1beta1.GCPClusterTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource(in *v1beta1.GCPClusterTemplateResource, out *GCPClusterTemplateResource, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPClusterSpec_To_v1alpha4_GCPClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource(in *v1beta1.GCPClusterTemplateResource, out *GCPClusterTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec(in *GCPClusterTemplateSpec, out *v1beta1.GCPClusterTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha4_GCPClusterTemplateResource_To_v1beta1_GCPClusterTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec(in *GCPClusterTemplateSpec, out *v1beta1.GCPClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPClusterTemplateSpec_To_v1beta1_GCPClusterTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec(in *v1beta1.GCPClusterTemplateSpec, out *GCPClusterTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_GCPClusterTemplateResource_To_v1alpha4_GCPClusterTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec(in *v1beta1.GCPClusterTemplateSpec, out *GCPClusterTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPClusterTemplateSpec_To_v1alpha4_GCPClusterTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine(in *GCPMachine, out *v1beta1.GCPMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine(in *GCPMachine, out *v1beta1.GCPMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachine_To_v1beta1_GCPMachine(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine(in *v1beta1.GCPMachine, out *GCPMachine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine(in *v1beta1.GCPMachine, out *GCPMachine, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachine_To_v1alpha4_GCPMachine(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList(in *GCPMachineList, out *v1beta1.GCPMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1beta1.GCPMachine)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList(in *GCPMachineList, out *v1beta1.GCPMachineList, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineList_To_v1beta1_GCPMachineList(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList(in *v1beta1.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GCPMachine)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList(in *v1beta1.GCPMachineList, out *GCPMachineList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineList_To_v1alpha4_GCPMachineList(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in *GCPMachineSpec, out *v1beta1.GCPMachineSpec, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.CustomMachineType = (*v1beta1.CustomMachineType)(unsafe.Pointer(in.CustomMachineType))
+	out.MinCPUPlatform = (*string)(unsafe.Pointer(in.MinCPUPlatform))
+	out.AdvancedMachineFeatures = (*v1beta1.AdvancedMachineFeatures)(unsafe.Pointer(in.AdvancedMachineFeatures))
+	out.GuestAccelerators = *(*[]v1beta1.GuestAccelerator)(unsafe.Pointer(&in.GuestAccelerators))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkInterfaces = *(*[]v1beta1.NetworkInterface)(unsafe.Pointer(&in.NetworkInterfaces))
+	out.NetworkTier = (*v1beta1.NetworkTier)(unsafe.Pointer(in.NetworkTier))
+	out.AddressReservations = (*v1beta1.AddressReservations)(unsafe.Pointer(in.AddressReservations))
+	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	out.FailureDomain = (*string)(unsafe.Pointer(in.FailureDomain))
+	out.FailureDomainFallback = in.FailureDomainFallback
+	out.ImageFamily = (*string)(unsafe.Pointer(in.ImageFamily))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.ImageLookup = (*v1beta1.ImageLookup)(unsafe.Pointer(in.ImageLookup))
+	out.ImageFamilyTemplate = (*string)(unsafe.Pointer(in.ImageFamilyTemplate))
+	out.AdditionalLabels = *(*v1beta1.Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.AdditionalMetadata = *(*[]v1beta1.MetadataItem)(unsafe.Pointer(&in.AdditionalMetadata))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	out.AdditionalNetworkTags = *(*[]string)(unsafe.Pointer(&in.AdditionalNetworkTags))
+	out.RootDeviceSize = in.RootDeviceSize
+	out.RootDeviceType = (*v1beta1.DiskType)(unsafe.Pointer(in.RootDeviceType))
+	out.AdditionalDisks = *(*[]v1beta1.AttachedDiskSpec)(unsafe.Pointer(&in.AdditionalDisks))
+	out.ServiceAccount = (*v1beta1.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
+	out.Preemptible = in.Preemptible
+	out.NodeAffinities = *(*[]v1beta1.NodeAffinity)(unsafe.Pointer(&in.NodeAffinities))
+	out.PlacementPolicy = (*string)(unsafe.Pointer(in.PlacementPolicy))
+	out.ReservationAffinity = (*v1beta1.ReservationAffinity)(unsafe.Pointer(in.ReservationAffinity))
+	out.BootstrapDataStorage = v1beta1.BootstrapDataStorage(in.BootstrapDataStorage)
+	out.BootstrapDataBucket = (*string)(unsafe.Pointer(in.BootstrapDataBucket))
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in *GCPMachineSpec, out *v1beta1.GCPMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in *v1beta1.GCPMachineSpec, out *GCPMachineSpec, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.CustomMachineType = (*CustomMachineType)(unsafe.Pointer(in.CustomMachineType))
+	out.MinCPUPlatform = (*string)(unsafe.Pointer(in.MinCPUPlatform))
+	out.AdvancedMachineFeatures = (*AdvancedMachineFeatures)(unsafe.Pointer(in.AdvancedMachineFeatures))
+	out.GuestAccelerators = *(*[]GuestAccelerator)(unsafe.Pointer(&in.GuestAccelerators))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkInterfaces = *(*[]NetworkInterface)(unsafe.Pointer(&in.NetworkInterfaces))
+	out.NetworkTier = (*NetworkTier)(unsafe.Pointer(in.NetworkTier))
+	out.AddressReservations = (*AddressReservations)(unsafe.Pointer(in.AddressReservations))
+	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	out.FailureDomain = (*string)(unsafe.Pointer(in.FailureDomain))
+	out.FailureDomainFallback = in.FailureDomainFallback
+	out.ImageFamily = (*string)(unsafe.Pointer(in.ImageFamily))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.ImageLookup = (*ImageLookup)(unsafe.Pointer(in.ImageLookup))
+	out.ImageFamilyTemplate = (*string)(unsafe.Pointer(in.ImageFamilyTemplate))
+	out.AdditionalLabels = *(*Labels)(unsafe.Pointer(&in.AdditionalLabels))
+	out.AdditionalMetadata = *(*[]MetadataItem)(unsafe.Pointer(&in.AdditionalMetadata))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	out.AdditionalNetworkTags = *(*[]string)(unsafe.Pointer(&in.AdditionalNetworkTags))
+	out.RootDeviceSize = in.RootDeviceSize
+	out.RootDeviceType = (*DiskType)(unsafe.Pointer(in.RootDeviceType))
+	out.AdditionalDisks = *(*[]AttachedDiskSpec)(unsafe.Pointer(&in.AdditionalDisks))
+	out.ServiceAccount = (*ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
+	out.Preemptible = in.Preemptible
+	out.NodeAffinities = *(*[]NodeAffinity)(unsafe.Pointer(&in.NodeAffinities))
+	out.PlacementPolicy = (*string)(unsafe.Pointer(in.PlacementPolicy))
+	out.ReservationAffinity = (*ReservationAffinity)(unsafe.Pointer(in.ReservationAffinity))
+	out.BootstrapDataStorage = BootstrapDataStorage(in.BootstrapDataStorage)
+	out.BootstrapDataBucket = (*string)(unsafe.Pointer(in.BootstrapDataBucket))
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in *v1beta1.GCPMachineSpec, out *GCPMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in *GCPMachineStatus, out *v1beta1.GCPMachineStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
+	out.InstanceStatus = (*v1beta1.InstanceStatus)(unsafe.Pointer(in.InstanceStatus))
+	out.InstanceName = (*string)(unsafe.Pointer(in.InstanceName))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.BootstrapDataSecret = (*string)(unsafe.Pointer(in.BootstrapDataSecret))
+	out.BootstrapDataObject = (*string)(unsafe.Pointer(in.BootstrapDataObject))
+	out.ReservedInternalAddress = (*string)(unsafe.Pointer(in.ReservedInternalAddress))
+	out.ReservedExternalAddress = (*string)(unsafe.Pointer(in.ReservedExternalAddress))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in *GCPMachineStatus, out *v1beta1.GCPMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineStatus_To_v1beta1_GCPMachineStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in *v1beta1.GCPMachineStatus, out *GCPMachineStatus, s conversion.Scope) error {
+	out.Ready = in.Ready
+	out.Addresses = *(*[]v1.NodeAddress)(unsafe.Pointer(&in.Addresses))
+	out.InstanceStatus = (*InstanceStatus)(unsafe.Pointer(in.InstanceStatus))
+	out.InstanceName = (*string)(unsafe.Pointer(in.InstanceName))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.BootstrapDataSecret = (*string)(unsafe.Pointer(in.BootstrapDataSecret))
+	out.BootstrapDataObject = (*string)(unsafe.Pointer(in.BootstrapDataObject))
+	out.ReservedInternalAddress = (*string)(unsafe.Pointer(in.ReservedInternalAddress))
+	out.ReservedExternalAddress = (*string)(unsafe.Pointer(in.ReservedExternalAddress))
+	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
+	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in *v1beta1.GCPMachineStatus, out *GCPMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineStatus_To_v1alpha4_GCPMachineStatus(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in *GCPMachineTemplate, out *v1beta1.GCPMachineTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in *GCPMachineTemplate, out *v1beta1.GCPMachineTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(in *v1beta1.GCPMachineTemplate, out *GCPMachineTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	// WARNING: in.Status requires manual conversion: does not exist in peer-type
+	return nil
+}
+
+func autoConvert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1beta1.GCPMachineTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta1.GCPMachineTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha4_GCPMachineTemplate_To_v1beta1_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in *GCPMachineTemplateList, out *v1beta1.GCPMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineTemplateList_To_v1beta1_GCPMachineTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in *v1beta1.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachineTemplate, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_GCPMachineTemplate_To_v1alpha4_GCPMachineTemplate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in *v1beta1.GCPMachineTemplateList, out *GCPMachineTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineTemplateList_To_v1alpha4_GCPMachineTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1beta1.GCPMachineTemplateResource, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha4_GCPMachineSpec_To_v1beta1_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in *GCPMachineTemplateResource, out *v1beta1.GCPMachineTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in *v1beta1.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_GCPMachineSpec_To_v1alpha4_GCPMachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in *v1beta1.GCPMachineTemplateResource, out *GCPMachineTemplateResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(in, out, s)
+}
+
+func autoConvert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1beta1.GCPMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha4_GCPMachineTemplateResource_To_v1beta1_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in *GCPMachineTemplateSpec, out *v1beta1.GCPMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GCPMachineTemplateSpec_To_v1beta1_GCPMachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in *v1beta1.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_GCPMachineTemplateResource_To_v1alpha4_GCPMachineTemplateResource(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in *v1beta1.GCPMachineTemplateSpec, out *GCPMachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPMachineTemplateSpec_To_v1alpha4_GCPMachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_GuestAccelerator_To_v1beta1_GuestAccelerator(in *GuestAccelerator, out *v1beta1.GuestAccelerator, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha4_GuestAccelerator_To_v1beta1_GuestAccelerator is an autogenerated conversion function.
+func Convert_v1alpha4_GuestAccelerator_To_v1beta1_GuestAccelerator(in *GuestAccelerator, out *v1beta1.GuestAccelerator, s conversion.Scope) error {
+	return autoConvert_v1alpha4_GuestAccelerator_To_v1beta1_GuestAccelerator(in, out, s)
+}
+
+func autoConvert_v1beta1_GuestAccelerator_To_v1alpha4_GuestAccelerator(in *v1beta1.GuestAccelerator, out *GuestAccelerator, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1beta1_GuestAccelerator_To_v1alpha4_GuestAccelerator is an autogenerated conversion function.
+func Convert_v1beta1_GuestAccelerator_To_v1alpha4_GuestAccelerator(in *v1beta1.GuestAccelerator, out *GuestAccelerator, s conversion.Scope) error {
+	return autoConvert_v1beta1_GuestAccelerator_To_v1alpha4_GuestAccelerator(in, out, s)
+}
+
+func autoConvert_v1alpha4_ImageLookup_To_v1beta1_ImageLookup(in *ImageLookup, out *v1beta1.ImageLookup, s conversion.Scope) error {
+	out.Project = (*string)(unsafe.Pointer(in.Project))
+	out.FamilyTemplate = (*string)(unsafe.Pointer(in.FamilyTemplate))
+	out.NameFilter = (*string)(unsafe.Pointer(in.NameFilter))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.BaseOS = (*string)(unsafe.Pointer(in.BaseOS))
+	return nil
+}
+
+// Convert_v1alpha4_ImageLookup_To_v1beta1_ImageLookup is an autogenerated conversion function.
+func Convert_v1alpha4_ImageLookup_To_v1beta1_ImageLookup(in *ImageLookup, out *v1beta1.ImageLookup, s conversion.Scope) error {
+	return autoConvert_v1alpha4_ImageLookup_To_v1beta1_ImageLookup(in, out, s)
+}
+
+func autoConvert_v1beta1_ImageLookup_To_v1alpha4_ImageLookup(in *v1beta1.ImageLookup, out *ImageLookup, s conversion.Scope) error {
+	out.Project = (*string)(unsafe.Pointer(in.Project))
+	out.FamilyTemplate = (*string)(unsafe.Pointer(in.FamilyTemplate))
+	out.NameFilter = (*string)(unsafe.Pointer(in.NameFilter))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.BaseOS = (*string)(unsafe.Pointer(in.BaseOS))
+	return nil
+}
+
+// Convert_v1beta1_ImageLookup_To_v1alpha4_ImageLookup is an autogenerated conversion function.
+func Convert_v1beta1_ImageLookup_To_v1alpha4_ImageLookup(in *v1beta1.ImageLookup, out *ImageLookup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImageLookup_To_v1alpha4_ImageLookup(in, out, s)
+}
+
+func autoConvert_v1alpha4_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *LoadBalancerSpec, out *v1beta1.LoadBalancerSpec, s conversion.Scope) error {
+	out.APIServerAddress = (*string)(unsafe.Pointer(in.APIServerAddress))
+	out.ProxyHeader = (*v1beta1.ProxyHeader)(unsafe.Pointer(in.ProxyHeader))
+	out.BackendTimeoutSec = (*int64)(unsafe.Pointer(in.BackendTimeoutSec))
+	out.ConnectionDrainingTimeoutSec = (*int64)(unsafe.Pointer(in.ConnectionDrainingTimeoutSec))
+	out.SessionAffinity = (*v1beta1.SessionAffinity)(unsafe.Pointer(in.SessionAffinity))
+	out.BalancingMode = (*v1beta1.BalancingMode)(unsafe.Pointer(in.BalancingMode))
+	out.MaxConnectionsPerInstance = (*int64)(unsafe.Pointer(in.MaxConnectionsPerInstance))
+	out.SecurityPolicy = (*string)(unsafe.Pointer(in.SecurityPolicy))
+	return nil
+}
+
+// Convert_v1alpha4_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1alpha4_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in *LoadBalancerSpec, out *v1beta1.LoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_LoadBalancerSpec_To_v1beta1_LoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_LoadBalancerSpec_To_v1alpha4_LoadBalancerSpec(in *v1beta1.LoadBalancerSpec, out *LoadBalancerSpec, s conversion.Scope) error {
+	out.APIServerAddress = (*string)(unsafe.Pointer(in.APIServerAddress))
+	out.ProxyHeader = (*ProxyHeader)(unsafe.Pointer(in.ProxyHeader))
+	out.BackendTimeoutSec = (*int64)(unsafe.Pointer(in.BackendTimeoutSec))
+	out.ConnectionDrainingTimeoutSec = (*int64)(unsafe.Pointer(in.ConnectionDrainingTimeoutSec))
+	out.SessionAffinity = (*SessionAffinity)(unsafe.Pointer(in.SessionAffinity))
+	out.BalancingMode = (*BalancingMode)(unsafe.Pointer(in.BalancingMode))
+	out.MaxConnectionsPerInstance = (*int64)(unsafe.Pointer(in.MaxConnectionsPerInstance))
+	out.SecurityPolicy = (*string)(unsafe.Pointer(in.SecurityPolicy))
+	return nil
+}
+
+// Convert_v1beta1_LoadBalancerSpec_To_v1alpha4_LoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1beta1_LoadBalancerSpec_To_v1alpha4_LoadBalancerSpec(in *v1beta1.LoadBalancerSpec, out *LoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoadBalancerSpec_To_v1alpha4_LoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_MetadataItem_To_v1beta1_MetadataItem(in *MetadataItem, out *v1beta1.MetadataItem, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_v1alpha4_MetadataItem_To_v1beta1_MetadataItem is an autogenerated conversion function.
+func Convert_v1alpha4_MetadataItem_To_v1beta1_MetadataItem(in *MetadataItem, out *v1beta1.MetadataItem, s conversion.Scope) error {
+	return autoConvert_v1alpha4_MetadataItem_To_v1beta1_MetadataItem(in, out, s)
+}
+
+func autoConvert_v1beta1_MetadataItem_To_v1alpha4_MetadataItem(in *v1beta1.MetadataItem, out *MetadataItem, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_v1beta1_MetadataItem_To_v1alpha4_MetadataItem is an autogenerated conversion function.
+func Convert_v1beta1_MetadataItem_To_v1alpha4_MetadataItem(in *v1beta1.MetadataItem, out *MetadataItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetadataItem_To_v1alpha4_MetadataItem(in, out, s)
+}
+
+func autoConvert_v1alpha4_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
+	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
+	out.FirewallRules = *(*map[string]string)(unsafe.Pointer(&in.FirewallRules))
+	out.Router = (*string)(unsafe.Pointer(in.Router))
+	out.APIServerAddress = (*string)(unsafe.Pointer(in.APIServerAddress))
+	out.APIServerIP = (*string)(unsafe.Pointer(in.APIServerIP))
+	out.APIServerHealthCheck = (*string)(unsafe.Pointer(in.APIServerHealthCheck))
+	out.APIServerInstanceGroups = *(*map[string]string)(unsafe.Pointer(&in.APIServerInstanceGroups))
+	out.APIServerBackendService = (*string)(unsafe.Pointer(in.APIServerBackendService))
+	out.APIServerTargetProxy = (*string)(unsafe.Pointer(in.APIServerTargetProxy))
+	out.APIServerForwardingRule = (*string)(unsafe.Pointer(in.APIServerForwardingRule))
+	out.APIServerIPv6Address = (*string)(unsafe.Pointer(in.APIServerIPv6Address))
+	out.APIServerIPv6 = (*string)(unsafe.Pointer(in.APIServerIPv6))
+	out.APIServerIPv6ForwardingRule = (*string)(unsafe.Pointer(in.APIServerIPv6ForwardingRule))
+	return nil
+}
+
+// Convert_v1alpha4_Network_To_v1beta1_Network is an autogenerated conversion function.
+func Convert_v1alpha4_Network_To_v1beta1_Network(in *Network, out *v1beta1.Network, s conversion.Scope) error {
+	return autoConvert_v1alpha4_Network_To_v1beta1_Network(in, out, s)
+}
+
+func autoConvert_v1beta1_Network_To_v1alpha4_Network(in *v1beta1.Network, out *Network, s conversion.Scope) error {
+	out.SelfLink = (*string)(unsafe.Pointer(in.SelfLink))
+	out.FirewallRules = *(*map[string]string)(unsafe.Pointer(&in.FirewallRules))
+	out.Router = (*string)(unsafe.Pointer(in.Router))
+	out.APIServerAddress = (*string)(unsafe.Pointer(in.APIServerAddress))
+	out.APIServerIP = (*string)(unsafe.Pointer(in.APIServerIP))
+	out.APIServerHealthCheck = (*string)(unsafe.Pointer(in.APIServerHealthCheck))
+	out.APIServerInstanceGroups = *(*map[string]string)(unsafe.Pointer(&in.APIServerInstanceGroups))
+	out.APIServerBackendService = (*string)(unsafe.Pointer(in.APIServerBackendService))
+	out.APIServerTargetProxy = (*string)(unsafe.Pointer(in.APIServerTargetProxy))
+	out.APIServerForwardingRule = (*string)(unsafe.Pointer(in.APIServerForwardingRule))
+	out.APIServerIPv6Address = (*string)(unsafe.Pointer(in.APIServerIPv6Address))
+	out.APIServerIPv6 = (*string)(unsafe.Pointer(in.APIServerIPv6))
+	out.APIServerIPv6ForwardingRule = (*string)(unsafe.Pointer(in.APIServerIPv6ForwardingRule))
+	return nil
+}
+
+// Convert_v1beta1_Network_To_v1alpha4_Network is an autogenerated conversion function.
+func Convert_v1beta1_Network_To_v1alpha4_Network(in *v1beta1.Network, out *Network, s conversion.Scope) error {
+	return autoConvert_v1beta1_Network_To_v1alpha4_Network(in, out, s)
+}
+
+func autoConvert_v1alpha4_NetworkInterface_To_v1beta1_NetworkInterface(in *NetworkInterface, out *v1beta1.NetworkInterface, s conversion.Scope) error {
+	out.Network = (*string)(unsafe.Pointer(in.Network))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkIP = (*string)(unsafe.Pointer(in.NetworkIP))
+	out.AliasIPRanges = *(*[]v1beta1.AliasIPRange)(unsafe.Pointer(&in.AliasIPRanges))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	out.NicType = (*v1beta1.NicType)(unsafe.Pointer(in.NicType))
+	out.StackType = (*v1beta1.StackType)(unsafe.Pointer(in.StackType))
+	out.IPv6PublicIP = (*bool)(unsafe.Pointer(in.IPv6PublicIP))
+	return nil
+}
+
+// Convert_v1alpha4_NetworkInterface_To_v1beta1_NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha4_NetworkInterface_To_v1beta1_NetworkInterface(in *NetworkInterface, out *v1beta1.NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha4_NetworkInterface_To_v1beta1_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkInterface_To_v1alpha4_NetworkInterface(in *v1beta1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.Network = (*string)(unsafe.Pointer(in.Network))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.NetworkIP = (*string)(unsafe.Pointer(in.NetworkIP))
+	out.AliasIPRanges = *(*[]AliasIPRange)(unsafe.Pointer(&in.AliasIPRanges))
+	out.PublicIP = (*bool)(unsafe.Pointer(in.PublicIP))
+	out.NicType = (*NicType)(unsafe.Pointer(in.NicType))
+	out.StackType = (*StackType)(unsafe.Pointer(in.StackType))
+	out.IPv6PublicIP = (*bool)(unsafe.Pointer(in.IPv6PublicIP))
+	return nil
+}
+
+// Convert_v1beta1_NetworkInterface_To_v1alpha4_NetworkInterface is an autogenerated conversion function.
+func Convert_v1beta1_NetworkInterface_To_v1alpha4_NetworkInterface(in *v1beta1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkInterface_To_v1alpha4_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec(in *NetworkSpec, out *v1beta1.NetworkSpec, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.AutoCreateSubnetworks = (*bool)(unsafe.Pointer(in.AutoCreateSubnetworks))
+	out.Subnets = *(*v1beta1.Subnets)(unsafe.Pointer(&in.Subnets))
+	out.LoadBalancerBackendPort = (*int32)(unsafe.Pointer(in.LoadBalancerBackendPort))
+	out.APIServerStackType = (*v1beta1.StackType)(unsafe.Pointer(in.APIServerStackType))
+	return nil
+}
+
+// Convert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec(in *NetworkSpec, out *v1beta1.NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_NetworkSpec_To_v1beta1_NetworkSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec(in *v1beta1.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.AutoCreateSubnetworks = (*bool)(unsafe.Pointer(in.AutoCreateSubnetworks))
+	out.Subnets = *(*Subnets)(unsafe.Pointer(&in.Subnets))
+	out.LoadBalancerBackendPort = (*int32)(unsafe.Pointer(in.LoadBalancerBackendPort))
+	out.APIServerStackType = (*StackType)(unsafe.Pointer(in.APIServerStackType))
+	return nil
+}
+
+// Convert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec is an autogenerated conversion function.
+func Convert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec(in *v1beta1.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkSpec_To_v1alpha4_NetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_NodeAffinity_To_v1beta1_NodeAffinity(in *NodeAffinity, out *v1beta1.NodeAffinity, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Operator = v1beta1.NodeAffinityOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.NodeGroup = (*string)(unsafe.Pointer(in.NodeGroup))
+	return nil
+}
+
+// Convert_v1alpha4_NodeAffinity_To_v1beta1_NodeAffinity is an autogenerated conversion function.
+func Convert_v1alpha4_NodeAffinity_To_v1beta1_NodeAffinity(in *NodeAffinity, out *v1beta1.NodeAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha4_NodeAffinity_To_v1beta1_NodeAffinity(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeAffinity_To_v1alpha4_NodeAffinity(in *v1beta1.NodeAffinity, out *NodeAffinity, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Operator = NodeAffinityOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.NodeGroup = (*string)(unsafe.Pointer(in.NodeGroup))
+	return nil
+}
+
+// Convert_v1beta1_NodeAffinity_To_v1alpha4_NodeAffinity is an autogenerated conversion function.
+func Convert_v1beta1_NodeAffinity_To_v1alpha4_NodeAffinity(in *v1beta1.NodeAffinity, out *NodeAffinity, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeAffinity_To_v1alpha4_NodeAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha4_PlacementPolicySpec_To_v1beta1_PlacementPolicySpec(in *PlacementPolicySpec, out *v1beta1.PlacementPolicySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Collocation = v1beta1.PlacementPolicyCollocation(in.Collocation)
+	out.AvailabilityDomainCount = (*int64)(unsafe.Pointer(in.AvailabilityDomainCount))
+	out.VMCount = (*int64)(unsafe.Pointer(in.VMCount))
+	return nil
+}
+
+// Convert_v1alpha4_PlacementPolicySpec_To_v1beta1_PlacementPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha4_PlacementPolicySpec_To_v1beta1_PlacementPolicySpec(in *PlacementPolicySpec, out *v1beta1.PlacementPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_PlacementPolicySpec_To_v1beta1_PlacementPolicySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_PlacementPolicySpec_To_v1alpha4_PlacementPolicySpec(in *v1beta1.PlacementPolicySpec, out *PlacementPolicySpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Collocation = PlacementPolicyCollocation(in.Collocation)
+	out.AvailabilityDomainCount = (*int64)(unsafe.Pointer(in.AvailabilityDomainCount))
+	out.VMCount = (*int64)(unsafe.Pointer(in.VMCount))
+	return nil
+}
+
+// Convert_v1beta1_PlacementPolicySpec_To_v1alpha4_PlacementPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_PlacementPolicySpec_To_v1alpha4_PlacementPolicySpec(in *v1beta1.PlacementPolicySpec, out *PlacementPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_PlacementPolicySpec_To_v1alpha4_PlacementPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha4_ReservationAffinity_To_v1beta1_ReservationAffinity(in *ReservationAffinity, out *v1beta1.ReservationAffinity, s conversion.Scope) error {
+	out.Type = v1beta1.ReservationAffinityType(in.Type)
+	out.Reservations = *(*[]string)(unsafe.Pointer(&in.Reservations))
+	return nil
+}
+
+// Convert_v1alpha4_ReservationAffinity_To_v1beta1_ReservationAffinity is an autogenerated conversion function.
+func Convert_v1alpha4_ReservationAffinity_To_v1beta1_ReservationAffinity(in *ReservationAffinity, out *v1beta1.ReservationAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha4_ReservationAffinity_To_v1beta1_ReservationAffinity(in, out, s)
+}
+
+func autoConvert_v1beta1_ReservationAffinity_To_v1alpha4_ReservationAffinity(in *v1beta1.ReservationAffinity, out *ReservationAffinity, s conversion.Scope) error {
+	out.Type = ReservationAffinityType(in.Type)
+	out.Reservations = *(*[]string)(unsafe.Pointer(&in.Reservations))
+	return nil
+}
+
+// Convert_v1beta1_ReservationAffinity_To_v1alpha4_ReservationAffinity is an autogenerated conversion function.
+func Convert_v1beta1_ReservationAffinity_To_v1alpha4_ReservationAffinity(in *v1beta1.ReservationAffinity, out *ReservationAffinity, s conversion.Scope) error {
+	return autoConvert_v1beta1_ReservationAffinity_To_v1alpha4_ReservationAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha4_ServiceAccount_To_v1beta1_ServiceAccount(in *ServiceAccount, out *v1beta1.ServiceAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1alpha4_ServiceAccount_To_v1beta1_ServiceAccount is an autogenerated conversion function.
+func Convert_v1alpha4_ServiceAccount_To_v1beta1_ServiceAccount(in *ServiceAccount, out *v1beta1.ServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha4_ServiceAccount_To_v1beta1_ServiceAccount(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceAccount_To_v1alpha4_ServiceAccount(in *v1beta1.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1beta1_ServiceAccount_To_v1alpha4_ServiceAccount is an autogenerated conversion function.
+func Convert_v1beta1_ServiceAccount_To_v1alpha4_ServiceAccount(in *v1beta1.ServiceAccount, out *ServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceAccount_To_v1alpha4_ServiceAccount(in, out, s)
+}
+
+func autoConvert_v1alpha4_SubnetSpec_To_v1beta1_SubnetSpec(in *SubnetSpec, out *v1beta1.SubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CidrBlock = in.CidrBlock
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	out.SecondaryCidrBlocks = *(*map[string]string)(unsafe.Pointer(&in.SecondaryCidrBlocks))
+	out.Region = in.Region
+	out.PrivateGoogleAccess = (*bool)(unsafe.Pointer(in.PrivateGoogleAccess))
+	out.EnableFlowLogs = (*bool)(unsafe.Pointer(in.EnableFlowLogs))
+	out.StackType = (*v1beta1.StackType)(unsafe.Pointer(in.StackType))
+	out.IPv6AccessType = (*v1beta1.IPv6AccessType)(unsafe.Pointer(in.IPv6AccessType))
+	return nil
+}
+
+// Convert_v1alpha4_SubnetSpec_To_v1beta1_SubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha4_SubnetSpec_To_v1beta1_SubnetSpec(in *SubnetSpec, out *v1beta1.SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha4_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(in *v1beta1.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CidrBlock = in.CidrBlock
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	out.SecondaryCidrBlocks = *(*map[string]string)(unsafe.Pointer(&in.SecondaryCidrBlocks))
+	out.Region = in.Region
+	out.PrivateGoogleAccess = (*bool)(unsafe.Pointer(in.PrivateGoogleAccess))
+	out.EnableFlowLogs = (*bool)(unsafe.Pointer(in.EnableFlowLogs))
+	out.StackType = (*StackType)(unsafe.Pointer(in.StackType))
+	out.IPv6AccessType = (*IPv6AccessType)(unsafe.Pointer(in.IPv6AccessType))
+	return nil
+}
+
+// Convert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec is an autogenerated conversion function.
+func Convert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(in *v1beta1.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SubnetSpec_To_v1alpha4_SubnetSpec(in, out, s)
+}
@@ -27,6 +27,91 @@ import (
 	"sigs.k8s.io/cluster-api/errors"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressReservation) DeepCopyInto(out *AddressReservation) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressReservation.
+func (in *AddressReservation) DeepCopy() *AddressReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressReservations) DeepCopyInto(out *AddressReservations) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(AddressReservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(AddressReservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressReservations.
+func (in *AddressReservations) DeepCopy() *AddressReservations {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressReservations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedMachineFeatures) DeepCopyInto(out *AdvancedMachineFeatures) {
+	*out = *in
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedMachineFeatures.
+func (in *AdvancedMachineFeatures) DeepCopy() *AdvancedMachineFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedMachineFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasIPRange) DeepCopyInto(out *AliasIPRange) {
+	*out = *in
+	if in.SubnetworkRangeName != nil {
+		in, out := &in.SubnetworkRangeName, &out.SubnetworkRangeName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasIPRange.
+func (in *AliasIPRange) DeepCopy() *AliasIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachedDiskSpec) DeepCopyInto(out *AttachedDiskSpec) {
 	*out = *in
@@ -40,6 +125,48 @@ func (in *AttachedDiskSpec) DeepCopyInto(out *AttachedDiskSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SourceSnapshot != nil {
+		in, out := &in.SourceSnapshot, &out.SourceSnapshot
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourcePolicies != nil {
+		in, out := &in.ResourcePolicies, &out.ResourcePolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedDiskSpec.
@@ -52,6 +179,71 @@ func (in *AttachedDiskSpec) DeepCopy() *AttachedDiskSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedCIDRBlocks != nil {
+		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSpec.
+func (in *BastionSpec) DeepCopy() *BastionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
+	*out = *in
+	if in.SelfLink != nil {
+		in, out := &in.SelfLink, &out.SelfLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionStatus.
+func (in *BastionStatus) DeepCopy() *BastionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildParams) DeepCopyInto(out *BuildParams) {
 	*out = *in
@@ -79,6 +271,66 @@ func (in *BuildParams) DeepCopy() *BuildParams {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneAccessSpec) DeepCopyInto(out *ControlPlaneAccessSpec) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneAccessSpec.
+func (in *ControlPlaneAccessSpec) DeepCopy() *ControlPlaneAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomMachineType) DeepCopyInto(out *CustomMachineType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomMachineType.
+func (in *CustomMachineType) DeepCopy() *CustomMachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
+	*out = *in
+	if in.ManagedZone != nil {
+		in, out := &in.ManagedZone, &out.ManagedZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerRecordName != nil {
+		in, out := &in.APIServerRecordName, &out.APIServerRecordName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
+func (in *DNSSpec) DeepCopy() *DNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -161,8 +413,28 @@ func (in *GCPClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPClusterSpec) DeepCopyInto(out *GCPClusterSpec) {
 	*out = *in
+	if in.ResourceNamePrefix != nil {
+		in, out := &in.ResourceNamePrefix, &out.ResourceNamePrefix
+		*out = new(string)
+		**out = **in
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	in.Network.DeepCopyInto(&out.Network)
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(NetworkTier)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneAccess != nil {
+		in, out := &in.ControlPlaneAccess, &out.ControlPlaneAccess
+		*out = new(ControlPlaneAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
 		*out = make([]string, len(*in))
@@ -175,6 +447,23 @@ func (in *GCPClusterSpec) DeepCopyInto(out *GCPClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PlacementPolicies != nil {
+		in, out := &in.PlacementPolicies, &out.PlacementPolicies
+		*out = make([]PlacementPolicySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterSpec.
@@ -198,6 +487,11 @@ func (in *GCPClusterStatus) DeepCopyInto(out *GCPClusterStatus) {
 		}
 	}
 	in.Network.DeepCopyInto(&out.Network)
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterStatus.
@@ -210,6 +504,97 @@ func (in *GCPClusterStatus) DeepCopy() *GCPClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplate) DeepCopyInto(out *GCPClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplate.
+func (in *GCPClusterTemplate) DeepCopy() *GCPClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateList) DeepCopyInto(out *GCPClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateList.
+func (in *GCPClusterTemplateList) DeepCopy() *GCPClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateResource) DeepCopyInto(out *GCPClusterTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateResource.
+func (in *GCPClusterTemplateResource) DeepCopy() *GCPClusterTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateSpec) DeepCopyInto(out *GCPClusterTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateSpec.
+func (in *GCPClusterTemplateSpec) DeepCopy() *GCPClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachine) DeepCopyInto(out *GCPMachine) {
 	*out = *in
@@ -272,16 +657,58 @@ func (in *GCPMachineList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachineSpec) DeepCopyInto(out *GCPMachineSpec) {
 	*out = *in
+	if in.CustomMachineType != nil {
+		in, out := &in.CustomMachineType, &out.CustomMachineType
+		*out = new(CustomMachineType)
+		**out = **in
+	}
+	if in.MinCPUPlatform != nil {
+		in, out := &in.MinCPUPlatform, &out.MinCPUPlatform
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvancedMachineFeatures != nil {
+		in, out := &in.AdvancedMachineFeatures, &out.AdvancedMachineFeatures
+		*out = new(AdvancedMachineFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestAccelerators != nil {
+		in, out := &in.GuestAccelerators, &out.GuestAccelerators
+		*out = make([]GuestAccelerator, len(*in))
+		copy(*out, *in)
+	}
 	if in.Subnet != nil {
 		in, out := &in.Subnet, &out.Subnet
 		*out = new(string)
 		**out = **in
 	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(NetworkTier)
+		**out = **in
+	}
+	if in.AddressReservations != nil {
+		in, out := &in.AddressReservations, &out.AddressReservations
+		*out = new(AddressReservations)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderID != nil {
 		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
 	if in.ImageFamily != nil {
 		in, out := &in.ImageFamily, &out.ImageFamily
 		*out = new(string)
@@ -292,6 +719,16 @@ func (in *GCPMachineSpec) DeepCopyInto(out *GCPMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImageLookup != nil {
+		in, out := &in.ImageLookup, &out.ImageLookup
+		*out = new(ImageLookup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageFamilyTemplate != nil {
+		in, out := &in.ImageFamilyTemplate, &out.ImageFamilyTemplate
+		*out = new(string)
+		**out = **in
+	}
 	if in.AdditionalLabels != nil {
 		in, out := &in.AdditionalLabels, &out.AdditionalLabels
 		*out = make(Labels, len(*in))
@@ -333,6 +770,28 @@ func (in *GCPMachineSpec) DeepCopyInto(out *GCPMachineSpec) {
 		*out = new(ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeAffinities != nil {
+		in, out := &in.NodeAffinities, &out.NodeAffinities
+		*out = make([]NodeAffinity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlacementPolicy != nil {
+		in, out := &in.PlacementPolicy, &out.PlacementPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservationAffinity != nil {
+		in, out := &in.ReservationAffinity, &out.ReservationAffinity
+		*out = new(ReservationAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapDataBucket != nil {
+		in, out := &in.BootstrapDataBucket, &out.BootstrapDataBucket
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineSpec.
@@ -358,6 +817,36 @@ func (in *GCPMachineStatus) DeepCopyInto(out *GCPMachineStatus) {
 		*out = new(InstanceStatus)
 		**out = **in
 	}
+	if in.InstanceName != nil {
+		in, out := &in.InstanceName, &out.InstanceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapDataSecret != nil {
+		in, out := &in.BootstrapDataSecret, &out.BootstrapDataSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapDataObject != nil {
+		in, out := &in.BootstrapDataObject, &out.BootstrapDataObject
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedInternalAddress != nil {
+		in, out := &in.ReservedInternalAddress, &out.ReservedInternalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedExternalAddress != nil {
+		in, out := &in.ReservedExternalAddress, &out.ReservedExternalAddress
+		*out = new(string)
+		**out = **in
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(errors.MachineStatusError)
@@ -441,6 +930,7 @@ func (in *GCPMachineTemplateList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachineTemplateResource) DeepCopyInto(out *GCPMachineTemplateResource) {
 	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
@@ -470,6 +960,63 @@ func (in *GCPMachineTemplateSpec) DeepCopy() *GCPMachineTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAccelerator) DeepCopyInto(out *GuestAccelerator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAccelerator.
+func (in *GuestAccelerator) DeepCopy() *GuestAccelerator {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAccelerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLookup) DeepCopyInto(out *ImageLookup) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.FamilyTemplate != nil {
+		in, out := &in.FamilyTemplate, &out.FamilyTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameFilter != nil {
+		in, out := &in.NameFilter, &out.NameFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BaseOS != nil {
+		in, out := &in.BaseOS, &out.BaseOS
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLookup.
+func (in *ImageLookup) DeepCopy() *ImageLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Labels) DeepCopyInto(out *Labels) {
 	{
@@ -491,6 +1038,61 @@ func (in Labels) DeepCopy() Labels {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.APIServerAddress != nil {
+		in, out := &in.APIServerAddress, &out.APIServerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(ProxyHeader)
+		**out = **in
+	}
+	if in.BackendTimeoutSec != nil {
+		in, out := &in.BackendTimeoutSec, &out.BackendTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConnectionDrainingTimeoutSec != nil {
+		in, out := &in.ConnectionDrainingTimeoutSec, &out.ConnectionDrainingTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(SessionAffinity)
+		**out = **in
+	}
+	if in.BalancingMode != nil {
+		in, out := &in.BalancingMode, &out.BalancingMode
+		*out = new(BalancingMode)
+		**out = **in
+	}
+	if in.MaxConnectionsPerInstance != nil {
+		in, out := &in.MaxConnectionsPerInstance, &out.MaxConnectionsPerInstance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SecurityPolicy != nil {
+		in, out := &in.SecurityPolicy, &out.SecurityPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataItem) DeepCopyInto(out *MetadataItem) {
 	*out = *in
@@ -536,6 +1138,11 @@ func (in *Network) DeepCopyInto(out *Network) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.APIServerIP != nil {
+		in, out := &in.APIServerIP, &out.APIServerIP
+		*out = new(string)
+		**out = **in
+	}
 	if in.APIServerHealthCheck != nil {
 		in, out := &in.APIServerHealthCheck, &out.APIServerHealthCheck
 		*out = new(string)
@@ -563,6 +1170,21 @@ func (in *Network) DeepCopyInto(out *Network) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.APIServerIPv6Address != nil {
+		in, out := &in.APIServerIPv6Address, &out.APIServerIPv6Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIPv6 != nil {
+		in, out := &in.APIServerIPv6, &out.APIServerIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIPv6ForwardingRule != nil {
+		in, out := &in.APIServerIPv6ForwardingRule, &out.APIServerIPv6ForwardingRule
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
@@ -575,6 +1197,63 @@ func (in *Network) DeepCopy() *Network {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkIP != nil {
+		in, out := &in.NetworkIP, &out.NetworkIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasIPRanges != nil {
+		in, out := &in.AliasIPRanges, &out.AliasIPRanges
+		*out = make([]AliasIPRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NicType != nil {
+		in, out := &in.NicType, &out.NicType
+		*out = new(NicType)
+		**out = **in
+	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(StackType)
+		**out = **in
+	}
+	if in.IPv6PublicIP != nil {
+		in, out := &in.IPv6PublicIP, &out.IPv6PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -604,6 +1283,11 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.APIServerStackType != nil {
+		in, out := &in.APIServerStackType, &out.APIServerStackType
+		*out = new(StackType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -616,6 +1300,76 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAffinity) DeepCopyInto(out *NodeAffinity) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeGroup != nil {
+		in, out := &in.NodeGroup, &out.NodeGroup
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAffinity.
+func (in *NodeAffinity) DeepCopy() *NodeAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicySpec) DeepCopyInto(out *PlacementPolicySpec) {
+	*out = *in
+	if in.AvailabilityDomainCount != nil {
+		in, out := &in.AvailabilityDomainCount, &out.AvailabilityDomainCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VMCount != nil {
+		in, out := &in.VMCount, &out.VMCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicySpec.
+func (in *PlacementPolicySpec) DeepCopy() *PlacementPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationAffinity) DeepCopyInto(out *ReservationAffinity) {
+	*out = *in
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationAffinity.
+func (in *ReservationAffinity) DeepCopy() *ReservationAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	*out = *in
@@ -661,6 +1415,16 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(StackType)
+		**out = **in
+	}
+	if in.IPv6AccessType != nil {
+		in, out := &in.IPv6AccessType, &out.IPv6AccessType
+		*out = new(IPv6AccessType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
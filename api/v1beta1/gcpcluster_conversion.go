@@ -14,18 +14,10 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package v1alpha4
+package v1beta1
 
-import (
-	ctrl "sigs.k8s.io/controller-runtime"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
-)
+// Hub marks GCPCluster as a conversion hub.
+func (*GCPCluster) Hub() {}
 
-// log is for logging in this package.
-var _ = logf.Log.WithName("gcpmachinetemplate-resource")
-
-func (r *GCPMachineTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewWebhookManagedBy(mgr).
-		For(r).
-		Complete()
-}
+// Hub marks GCPClusterList as a conversion hub.
+func (*GCPClusterList) Hub() {}
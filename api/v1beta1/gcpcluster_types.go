@@ -0,0 +1,380 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+)
+
+const (
+	// ClusterFinalizer allows ReconcileGCPCluster to clean up GCP resources associated with GCPCluster before
+	// removing it from the apiserver.
+	ClusterFinalizer = "gcpcluster.infrastructure.cluster.x-k8s.io"
+)
+
+// GCPClusterSpec defines the desired state of GCPCluster.
+type GCPClusterSpec struct {
+	// Project is the name of the project to deploy the cluster to.
+	Project string `json:"project"`
+
+	// The GCP Region the cluster lives in.
+	Region string `json:"region"`
+
+	// ResourceNamePrefix is the prefix of the names of the GCP resources of the cluster.
+	// Defaults to the name of the cluster suffixed with a hash of its namespace and name,
+	// truncated to fit the length limits of GCP, and is recorded when the cluster is first reconciled.
+	// Clusters created before it was recorded keep the name of the cluster. Immutable once set.
+	// +kubebuilder:validation:MaxLength=30
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	ResourceNamePrefix *string `json:"resourceNamePrefix,omitempty"`
+
+	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
+	// +optional
+	ControlPlaneEndpoint clusterv1.APIEndpoint `json:"controlPlaneEndpoint"`
+
+	// NetworkSpec encapsulates all things related to GCP network.
+	// +optional
+	Network NetworkSpec `json:"network"`
+
+	// NetworkTier is the network tier of the API server address, and the default network tier
+	// of the external addresses of the GCPMachines. With the Standard tier the API server load balancer
+	// is a regional pass-through network load balancer, and the control plane endpoint uses the
+	// load balancer backend port instead of the API server port of the Cluster.
+	// Defaults to Premium.
+	// +optional
+	NetworkTier *NetworkTier `json:"networkTier,omitempty"`
+
+	// LoadBalancer configures the API server load balancer.
+	// +optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+
+	// ControlPlaneAccess restricts the clients of the control plane endpoint. By default the
+	// endpoint is reachable from any address.
+	// +optional
+	ControlPlaneAccess *ControlPlaneAccessSpec `json:"controlPlaneAccess,omitempty"`
+
+	// FailureDomains is an optional field which is used to assign selected availability zones to a cluster
+	// FailureDomains if empty, defaults to all the zones in the selected region and if specified would override
+	// the default zones.
+	// +optional
+	FailureDomains []string `json:"failureDomains,omitempty"`
+
+	// AdditionalLabels is an optional set of tags to add to GCP resources managed by the GCP provider, in addition to the
+	// ones added by default.
+	// +optional
+	AdditionalLabels Labels `json:"additionalLabels,omitempty"`
+
+	// PlacementPolicies are placement resource policies created in the region of the cluster,
+	// which GCPMachines can reference by name. They are deleted with the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PlacementPolicies []PlacementPolicySpec `json:"placementPolicies,omitempty"`
+
+	// Bastion is an optional bastion host created in the network of the cluster, to reach the
+	// nodes of private clusters over SSH. It is owned by the cluster and deleted with it.
+	// +optional
+	Bastion *BastionSpec `json:"bastion,omitempty"`
+
+	// DNS publishes the API server address in a Cloud DNS managed zone, and uses the DNS name as the
+	// host of the control plane endpoint, so that it's stable across load balancer re-creations.
+	// +optional
+	DNS *DNSSpec `json:"dns,omitempty"`
+}
+
+// DNSZoneVisibility defines the visibility of a Cloud DNS managed zone.
+// +kubebuilder:validation:Enum=Private;Public
+type DNSZoneVisibility string
+
+const (
+	// DNSZoneVisibilityPrivate makes the managed zone only visible from the network of the cluster.
+	DNSZoneVisibilityPrivate DNSZoneVisibility = "Private"
+	// DNSZoneVisibilityPublic makes the managed zone visible from the internet.
+	DNSZoneVisibilityPublic DNSZoneVisibility = "Public"
+)
+
+// DNSSpec defines the DNS name of the control plane endpoint.
+type DNSSpec struct {
+	// Domain is the DNS name of the managed zone, e.g. "k8s.example.com".
+	Domain string `json:"domain"`
+
+	// ManagedZone is the name of an existing managed zone of the project serving Domain, which
+	// is never deleted. When unset, a managed zone named after the cluster is created and
+	// deleted with the cluster.
+	// +optional
+	ManagedZone *string `json:"managedZone,omitempty"`
+
+	// Visibility is the visibility of the managed zone created for the cluster. Defaults to "Public".
+	// The control plane endpoint of a "Private" zone can only be resolved from the network of the
+	// cluster, so the management cluster must run in that network.
+	// +optional
+	Visibility DNSZoneVisibility `json:"visibility,omitempty"`
+
+	// APIServerRecordName is the name of the A record of the API server, relative to Domain,
+	// and of its AAAA record when the API server frontend is dual-stack.
+	// Defaults to "api.<cluster name>".
+	// +optional
+	APIServerRecordName *string `json:"apiServerRecordName,omitempty"`
+}
+
+// BastionAccessMode defines how the bastion host is reached.
+// +kubebuilder:validation:Enum=SSH;IAP
+type BastionAccessMode string
+
+const (
+	// BastionAccessModeSSH allows SSH connections to the bastion host from the allowed CIDR blocks.
+	BastionAccessModeSSH BastionAccessMode = "SSH"
+	// BastionAccessModeIAP only allows SSH connections tunneled through Identity-Aware Proxy TCP forwarding.
+	BastionAccessModeIAP BastionAccessMode = "IAP"
+)
+
+// BastionSpec defines the bastion host of a cluster.
+type BastionSpec struct {
+	// InstanceType is the machine type of the bastion host. Defaults to "e2-micro".
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// Image is the full reference to a valid image or image family of the boot disk of the bastion host.
+	// Defaults to "projects/debian-cloud/global/images/family/debian-11".
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// Zone is the zone of the bastion host. Defaults to the first failure domain of the cluster.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// Subnet is the name of the subnetwork of the bastion host, in the region of the cluster.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// AccessMode defines how the bastion host is reached. With "SSH" the firewall allows SSH connections
+	// from AllowedCIDRBlocks, and with "IAP" it only allows the Identity-Aware Proxy TCP forwarding range.
+	// Defaults to "SSH".
+	// +optional
+	AccessMode BastionAccessMode `json:"accessMode,omitempty"`
+
+	// AllowedCIDRBlocks are the source ranges allowed to connect to the bastion host over SSH.
+	// Only supported with the "SSH" access mode. Defaults to 0.0.0.0/0.
+	// +optional
+	AllowedCIDRBlocks []string `json:"allowedCIDRBlocks,omitempty"`
+
+	// PublicIP specifies whether the bastion host gets an external IP address.
+	// Defaults to true with the "SSH" access mode, and to false with the "IAP" access mode.
+	// +optional
+	PublicIP *bool `json:"publicIP,omitempty"`
+}
+
+// ControlPlaneAccessSpec restricts the clients of the control plane endpoint.
+type ControlPlaneAccessSpec struct {
+	// AllowedCIDRs are the source ranges allowed to connect to the control plane endpoint, which must
+	// include the external addresses of the nodes reaching it, such as the Cloud NAT addresses.
+	// With the Premium network tier they're enforced by a Cloud Armor security policy attached to the
+	// backend service of the API server load balancer, and with the Standard network tier by the
+	// firewall rule of the API servers. The ranges can't overlap, and IPv6 ranges require the
+	// IPV4_IPV6 API server stack type.
+	// +kubebuilder:validation:MinItems=1
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+}
+
+// ProxyHeader is the type of proxy header prepended to the connections forwarded to the backends.
+// +kubebuilder:validation:Enum=NONE;PROXY_V1
+type ProxyHeader string
+
+const (
+	// ProxyHeaderNone doesn't prepend any header.
+	ProxyHeaderNone ProxyHeader = "NONE"
+	// ProxyHeaderProxyV1 prepends a PROXY protocol version 1 header, carrying the client address.
+	ProxyHeaderProxyV1 ProxyHeader = "PROXY_V1"
+)
+
+// SessionAffinity is the session affinity of the API server load balancer.
+// +kubebuilder:validation:Enum=NONE;CLIENT_IP;CLIENT_IP_PROTO;CLIENT_IP_PORT_PROTO
+type SessionAffinity string
+
+const (
+	// SessionAffinityNone doesn't pin clients to backends.
+	SessionAffinityNone SessionAffinity = "NONE"
+	// SessionAffinityClientIP pins connections from the same client IP address to the same backend.
+	SessionAffinityClientIP SessionAffinity = "CLIENT_IP"
+	// SessionAffinityClientIPProto pins connections from the same client IP address and protocol.
+	// Only supported by the regional load balancer of the Standard network tier.
+	SessionAffinityClientIPProto SessionAffinity = "CLIENT_IP_PROTO"
+	// SessionAffinityClientIPPortProto pins connections from the same client IP address, port and protocol.
+	// Only supported by the regional load balancer of the Standard network tier.
+	SessionAffinityClientIPPortProto SessionAffinity = "CLIENT_IP_PORT_PROTO"
+)
+
+// BalancingMode is the balancing mode of the API server load balancer backends.
+// +kubebuilder:validation:Enum=UTILIZATION;CONNECTION
+type BalancingMode string
+
+const (
+	// BalancingModeUtilization balances connections based on the CPU utilization of the instances.
+	BalancingModeUtilization BalancingMode = "UTILIZATION"
+	// BalancingModeConnection balances connections based on their number.
+	BalancingModeConnection BalancingMode = "CONNECTION"
+)
+
+// LoadBalancerSpec configures the API server load balancer.
+// The load balancer is a TCP proxy forwarding the TLS connections to the API servers as is: a TLS/SSL
+// proxy would terminate them, and the API servers would no longer see the client certificates.
+type LoadBalancerSpec struct {
+	// APIServerAddress is the name or the IP address of an existing external address of the project
+	// used by the load balancer, instead of reserving one for the cluster. It must be a regional address
+	// in the region of the cluster with the Standard network tier, and a global address otherwise.
+	// The address is never deleted. Immutable.
+	// +optional
+	APIServerAddress *string `json:"apiServerAddress,omitempty"`
+
+	// ProxyHeader is the proxy header prepended to the connections forwarded to the API servers.
+	// kube-apiserver doesn't accept the PROXY protocol: "PROXY_V1" requires a proxy-aware frontend,
+	// e.g. HAProxy, listening on the backend port of the control plane machines in front of the API
+	// server, which the provider doesn't configure. Immutable, as switching it would break every
+	// connection to the existing frontends. Only supported with the Premium network tier.
+	// Defaults to "NONE".
+	// +optional
+	ProxyHeader *ProxyHeader `json:"proxyHeader,omitempty"`
+
+	// BackendTimeoutSec is the time in seconds the load balancer waits for the API servers.
+	// Only supported with the Premium network tier. Defaults to 600.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BackendTimeoutSec *int64 `json:"backendTimeoutSec,omitempty"`
+
+	// ConnectionDrainingTimeoutSec is the time in seconds the load balancer waits for existing
+	// connections to complete when an API server is removed.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	ConnectionDrainingTimeoutSec *int64 `json:"connectionDrainingTimeoutSec,omitempty"`
+
+	// SessionAffinity is the session affinity of the load balancer. Defaults to "NONE".
+	// +optional
+	SessionAffinity *SessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// BalancingMode is the balancing mode of the backends. The regional load balancer of the Standard
+	// network tier only supports "CONNECTION". Defaults to "UTILIZATION" with the Premium network tier,
+	// and "CONNECTION" with the Standard network tier.
+	// +optional
+	BalancingMode *BalancingMode `json:"balancingMode,omitempty"`
+
+	// MaxConnectionsPerInstance is the target number of connections per API server of the "CONNECTION"
+	// balancing mode. Required with the "CONNECTION" balancing mode and the Premium network tier,
+	// and not supported with the Standard network tier.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConnectionsPerInstance *int64 `json:"maxConnectionsPerInstance,omitempty"`
+
+	// SecurityPolicy is the name of an existing Cloud Armor security policy of the project, attached
+	// to the backend service to filter the clients of the API servers. Only supported with the Premium network tier.
+	// +optional
+	SecurityPolicy *string `json:"securityPolicy,omitempty"`
+}
+
+// PlacementPolicyCollocation defines how the instances of a placement policy are placed.
+// +kubebuilder:validation:Enum=Spread;Compact
+type PlacementPolicyCollocation string
+
+const (
+	// PlacementPolicyCollocationSpread spreads the instances across availability domains.
+	PlacementPolicyCollocationSpread PlacementPolicyCollocation = "Spread"
+	// PlacementPolicyCollocationCompact places the instances close to each other, for low network latency.
+	PlacementPolicyCollocationCompact PlacementPolicyCollocation = "Compact"
+)
+
+// PlacementPolicySpec defines a placement resource policy.
+type PlacementPolicySpec struct {
+	// Name is the name of the placement policy, referenced by GCPMachines.
+	// The resource policy is named "<cluster name>-<name>".
+	Name string `json:"name"`
+
+	// Collocation defines how the instances are placed. Defaults to "Spread".
+	// +optional
+	Collocation PlacementPolicyCollocation `json:"collocation,omitempty"`
+
+	// AvailabilityDomainCount is the number of availability domains the instances are spread across.
+	// Only supported with the "Spread" collocation.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8
+	// +optional
+	AvailabilityDomainCount *int64 `json:"availabilityDomainCount,omitempty"`
+
+	// VMCount is the number of instances of the policy. Only supported with the "Compact" collocation.
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	VMCount *int64 `json:"vmCount,omitempty"`
+}
+
+// GCPClusterStatus defines the observed state of GCPCluster.
+type GCPClusterStatus struct {
+	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
+	Network        Network                  `json:"network,omitempty"`
+
+	// Bastion is the observed state of the bastion host, if any.
+	// +optional
+	Bastion *BastionStatus `json:"bastion,omitempty"`
+
+	Ready bool `json:"ready"`
+}
+
+// BastionStatus defines the observed state of the bastion host.
+type BastionStatus struct {
+	// SelfLink is the full reference to the bastion instance.
+	// +optional
+	SelfLink *string `json:"selfLink,omitempty"`
+
+	// Zone is the zone of the bastion instance.
+	Zone string `json:"zone"`
+
+	// Addresses are the internal and external addresses of the bastion instance.
+	// +optional
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=gcpclusters,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this GCPCluster belongs"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for GCE instances"
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".spec.network.name",description="GCP network the cluster is using"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.apiEndpoints[0]",description="API Endpoint",priority=1
+
+// GCPCluster is the Schema for the gcpclusters API.
+type GCPCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPClusterSpec   `json:"spec,omitempty"`
+	Status GCPClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPClusterList contains a list of GCPCluster.
+type GCPClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPCluster{}, &GCPClusterList{})
+}
@@ -0,0 +1,481 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/util/naming"
+)
+
+// clusterlog is for logging in this package.
+var clusterlog = logf.Log.WithName("gcpcluster-resource")
+
+// defaultNetworkName is the name of the default network of GCP projects.
+const defaultNetworkName = "default"
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (c *GCPCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(c).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpcluster,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpclusters,versions=v1beta1,name=validation.gcpcluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-gcpcluster,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpclusters,versions=v1beta1,name=default.gcpcluster.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &GCPCluster{}
+var _ webhook.Defaulter = &GCPCluster{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (c *GCPCluster) Default() {
+	clusterlog.Info("default", "name", c.Name)
+
+	c.Spec.setDefaults()
+}
+
+// setDefaults sets the default values of a GCPClusterSpec.
+func (s *GCPClusterSpec) setDefaults() {
+	if s.Network.Name == nil {
+		s.Network.Name = pointer.String(defaultNetworkName)
+	}
+
+	if s.Network.AutoCreateSubnetworks == nil {
+		s.Network.AutoCreateSubnetworks = pointer.Bool(true)
+	}
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (c *GCPCluster) ValidateCreate() error {
+	clusterlog.Info("validate create", "name", c.Name)
+
+	allErrs := c.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPCluster").GroupKind(), c.Name, allErrs)
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (c *GCPCluster) ValidateUpdate(oldRaw runtime.Object) error {
+	clusterlog.Info("validate update", "name", c.Name)
+	var allErrs field.ErrorList
+	old := oldRaw.(*GCPCluster)
+
+	if !reflect.DeepEqual(c.Spec.Project, old.Spec.Project) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "Project"),
+				c.Spec.Project, "field is immutable"),
+		)
+	}
+
+	if !reflect.DeepEqual(c.Spec.Region, old.Spec.Region) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "Region"),
+				c.Spec.Region, "field is immutable"),
+		)
+	}
+
+	// Renaming the resources of the cluster would orphan them. The prefix is recorded
+	// by the controller when the cluster is first reconciled.
+	if old.Spec.ResourceNamePrefix != nil && !reflect.DeepEqual(c.Spec.ResourceNamePrefix, old.Spec.ResourceNamePrefix) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "resourceNamePrefix"),
+				c.Spec.ResourceNamePrefix, "field is immutable"),
+		)
+	}
+
+	// Placement policies are immutable, as removed policies wouldn't be deleted.
+	if !reflect.DeepEqual(c.Spec.PlacementPolicies, old.Spec.PlacementPolicies) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "placementPolicies"),
+				c.Spec.PlacementPolicies, "field is immutable"),
+		)
+	}
+
+	// The network tier selects the architecture of the API server load balancer.
+	if !reflect.DeepEqual(c.Spec.NetworkTier, old.Spec.NetworkTier) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "networkTier"),
+				c.Spec.NetworkTier, "field is immutable"),
+		)
+	}
+
+	// The frontend address of a forwarding rule can't be updated in place.
+	if !reflect.DeepEqual(c.Spec.LoadBalancer.apiServerAddress(), old.Spec.LoadBalancer.apiServerAddress()) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "loadBalancer", "apiServerAddress"),
+				c.Spec.LoadBalancer.apiServerAddress(), "field is immutable"),
+		)
+	}
+
+	// The proxy-aware frontends of the API servers expect the proxy header or not, changing it would
+	// break every connection to the existing control plane machines.
+	if !reflect.DeepEqual(c.Spec.LoadBalancer.proxyHeader(), old.Spec.LoadBalancer.proxyHeader()) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "loadBalancer", "proxyHeader"),
+				c.Spec.LoadBalancer.proxyHeader(), "field is immutable"),
+		)
+	}
+
+	// The control plane endpoint can't change once it's published.
+	if !reflect.DeepEqual(c.Spec.DNS, old.Spec.DNS) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "dns"),
+				c.Spec.DNS, "field is immutable"),
+		)
+	}
+
+	// The bastion instance isn't recreated, only its firewall rules follow the spec.
+	if c.Spec.Bastion != nil && old.Spec.Bastion != nil {
+		bastion, oldBastion := c.Spec.Bastion.DeepCopy(), old.Spec.Bastion.DeepCopy()
+		bastion.AccessMode, oldBastion.AccessMode = "", ""
+		bastion.AllowedCIDRBlocks, oldBastion.AllowedCIDRBlocks = nil, nil
+		if !reflect.DeepEqual(bastion, oldBastion) {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "bastion"),
+					c.Spec.Bastion, "only accessMode and allowedCIDRBlocks are mutable"),
+			)
+		}
+	}
+
+	// Only the errors introduced by the update are reported, so that clusters accepted before
+	// a validation rule was added can still be updated, e.g. to remove their finalizer.
+	oldSpec := old.Spec.DeepCopy()
+	oldSpec.setDefaults()
+	allErrs = append(allErrs, newErrors(c.Spec.validate(field.NewPath("spec")), oldSpec.validate(field.NewPath("spec")))...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("GCPCluster").GroupKind(), c.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (c *GCPCluster) ValidateDelete() error {
+	clusterlog.Info("validate delete", "name", c.Name)
+
+	return nil
+}
+
+// validate validates the content of a GCPClusterSpec.
+func (s *GCPClusterSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s.ResourceNamePrefix != nil {
+		if errs := naming.Validate(*s.ResourceNamePrefix, naming.MaxPrefixLength); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceNamePrefix"), *s.ResourceNamePrefix, strings.Join(errs, ", ")))
+		}
+	}
+
+	for i := range s.PlacementPolicies {
+		allErrs = append(allErrs, s.PlacementPolicies[i].validate(fldPath.Child("placementPolicies").Index(i))...)
+	}
+
+	customMode := s.Network.AutoCreateSubnetworks != nil && !*s.Network.AutoCreateSubnetworks
+	for i, subnet := range s.Network.Subnets {
+		allErrs = append(allErrs, subnet.validate(fldPath.Child("network", "subnets").Index(i))...)
+		// Dual-stack subnetworks can only be created in custom mode networks.
+		if t := subnet.StackType; t != nil && *t == StackTypeIPv4IPv6 && !customMode {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("network", "subnets").Index(i).Child("stackType"),
+				fmt.Sprintf("%q requires autoCreateSubnetworks to be false", *t)))
+		}
+	}
+	allErrs = append(allErrs, s.Network.validateSubnetRanges(fldPath.Child("network", "subnets"))...)
+
+	for i, zone := range s.FailureDomains {
+		if !isZoneOfRegion(zone, s.Region) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("failureDomains").Index(i), zone, fmt.Sprintf("must be a zone of region %q", s.Region)))
+		}
+	}
+
+	allErrs = append(allErrs, s.AdditionalLabels.validate(fldPath.Child("additionalLabels"))...)
+
+	if t := s.Network.APIServerStackType; t != nil && *t == StackTypeIPv4IPv6 && s.NetworkTier != nil && *s.NetworkTier != NetworkTierPremium {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("network", "apiServerStackType"), fmt.Sprintf("%q requires the %q network tier", *t, NetworkTierPremium)))
+	}
+
+	if s.Bastion != nil {
+		allErrs = append(allErrs, s.Bastion.validate(fldPath.Child("bastion"))...)
+		if zone := s.Bastion.Zone; zone != nil && !isZoneOfRegion(*zone, s.Region) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bastion", "zone"), *zone, fmt.Sprintf("must be a zone of region %q", s.Region)))
+		}
+	}
+
+	if s.DNS != nil {
+		allErrs = append(allErrs, s.DNS.validate(fldPath.Child("dns"))...)
+	}
+
+	if s.ControlPlaneAccess != nil {
+		dualStack := s.Network.APIServerStackType != nil && *s.Network.APIServerStackType == StackTypeIPv4IPv6
+		allErrs = append(allErrs, s.ControlPlaneAccess.validate(fldPath.Child("controlPlaneAccess"), dualStack)...)
+		if s.LoadBalancer != nil && s.LoadBalancer.SecurityPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("loadBalancer", "securityPolicy"), "is mutually exclusive with controlPlaneAccess"))
+		}
+	}
+
+	if s.LoadBalancer != nil {
+		standard := s.NetworkTier != nil && *s.NetworkTier == NetworkTierStandard
+		allErrs = append(allErrs, s.LoadBalancer.validate(fldPath.Child("loadBalancer"), standard)...)
+	}
+
+	return allErrs
+}
+
+// validate validates the control plane access, dualStack reporting whether the API server load balancer
+// has an IPv6 frontend. Without one, the allowed ranges are IPv4 ranges, also used in firewall rules which
+// can't mix IP versions.
+func (a *ControlPlaneAccessSpec) validate(fldPath *field.Path, dualStack bool) field.ErrorList {
+	var allErrs field.ErrorList
+	nets := make([]*net.IPNet, len(a.AllowedCIDRs))
+	for i, cidr := range a.AllowedCIDRs {
+		cidrPath := fldPath.Child("allowedCIDRs").Index(i)
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, cidr, "must be a CIDR range"))
+			continue
+		}
+
+		if ipNet.IP.To4() == nil && !dualStack {
+			allErrs = append(allErrs, field.Forbidden(cidrPath, fmt.Sprintf("IPv6 ranges require the %q API server stack type", StackTypeIPv4IPv6)))
+		}
+
+		for j, other := range nets[:i] {
+			if other != nil && (other.Contains(ipNet.IP) || ipNet.Contains(other.IP)) {
+				allErrs = append(allErrs, field.Invalid(cidrPath, cidr, fmt.Sprintf("overlaps with %s (%s)", fldPath.Child("allowedCIDRs").Index(j), a.AllowedCIDRs[j])))
+			}
+		}
+		nets[i] = ipNet
+	}
+
+	return allErrs
+}
+
+// apiServerAddress returns the existing address used by the load balancer, if any.
+func (l *LoadBalancerSpec) apiServerAddress() *string {
+	if l == nil {
+		return nil
+	}
+
+	return l.APIServerAddress
+}
+
+// proxyHeader returns the proxy header of the load balancer, defaulting to NONE.
+func (l *LoadBalancerSpec) proxyHeader() ProxyHeader {
+	if l == nil || l.ProxyHeader == nil {
+		return ProxyHeaderNone
+	}
+
+	return *l.ProxyHeader
+}
+
+// validate validates a load balancer spec, standard reporting whether it's the regional
+// pass-through load balancer of the Standard network tier.
+func (l *LoadBalancerSpec) validate(fldPath *field.Path, standard bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if standard {
+		notSupported := fmt.Sprintf("is not supported with the %q network tier", NetworkTierStandard)
+		if l.proxyHeader() != ProxyHeaderNone {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxyHeader"), notSupported))
+		}
+		if l.BackendTimeoutSec != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("backendTimeoutSec"), notSupported))
+		}
+		if l.BalancingMode != nil && *l.BalancingMode != BalancingModeConnection {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("balancingMode"), *l.BalancingMode, []string{string(BalancingModeConnection)}))
+		}
+		if l.MaxConnectionsPerInstance != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxConnectionsPerInstance"), notSupported))
+		}
+		if l.SecurityPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("securityPolicy"), notSupported))
+		}
+
+		return allErrs
+	}
+
+	if a := l.SessionAffinity; a != nil && *a != SessionAffinityNone && *a != SessionAffinityClientIP {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sessionAffinity"), *a, []string{string(SessionAffinityNone), string(SessionAffinityClientIP)}))
+	}
+
+	connection := l.BalancingMode != nil && *l.BalancingMode == BalancingModeConnection
+	if connection && l.MaxConnectionsPerInstance == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maxConnectionsPerInstance"), fmt.Sprintf("is required with the %q balancing mode", BalancingModeConnection)))
+	}
+	if !connection && l.MaxConnectionsPerInstance != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxConnectionsPerInstance"), fmt.Sprintf("is only supported with the %q balancing mode", BalancingModeConnection)))
+	}
+
+	return allErrs
+}
+
+func (d *DNSSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if d.Domain == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("domain"), "is required"))
+	}
+
+	if d.ManagedZone != nil && d.Visibility != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("visibility"), "is only supported for the managed zone created for the cluster"))
+	}
+
+	return allErrs
+}
+
+func (b *BastionSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if b.AccessMode == BastionAccessModeIAP && len(b.AllowedCIDRBlocks) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowedCIDRBlocks"), fmt.Sprintf("is not supported with the %q access mode", BastionAccessModeIAP)))
+	}
+
+	for i, cidr := range b.AllowedCIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedCIDRBlocks").Index(i), cidr, "must be a CIDR range"))
+		}
+	}
+
+	return allErrs
+}
+
+// isZoneOfRegion reports whether zone is a zone of region, e.g. "us-central1-a" of "us-central1".
+func isZoneOfRegion(zone, region string) bool {
+	suffix := strings.TrimPrefix(zone, region+"-")
+	return suffix != zone && suffix != "" && !strings.Contains(suffix, "-")
+}
+
+func (s *SubnetSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if s.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "is required"))
+	} else if errs := naming.Validate(s.Name, naming.MaxLength); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), s.Name, strings.Join(errs, ", ")))
+	}
+
+	if _, _, err := net.ParseCIDR(s.CidrBlock); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cidrBlock"), s.CidrBlock, "must be a CIDR range"))
+	}
+
+	for _, name := range s.secondaryRangeNames() {
+		if errs := naming.Validate(name, naming.MaxLength); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryCidrBlocks"), name, strings.Join(errs, ", ")))
+		}
+
+		if _, _, err := net.ParseCIDR(s.SecondaryCidrBlocks[name]); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryCidrBlocks").Key(name), s.SecondaryCidrBlocks[name], "must be a CIDR range"))
+		}
+	}
+
+	dualStack := s.StackType != nil && *s.StackType == StackTypeIPv4IPv6
+	if dualStack && s.IPv6AccessType == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ipv6AccessType"), fmt.Sprintf("is required when stackType is %q", StackTypeIPv4IPv6)))
+	}
+
+	if !dualStack && s.IPv6AccessType != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipv6AccessType"), fmt.Sprintf("is only supported when stackType is %q", StackTypeIPv4IPv6)))
+	}
+
+	return allErrs
+}
+
+// secondaryRangeNames returns the sorted names of the secondary ranges of the subnet.
+func (s *SubnetSpec) secondaryRangeNames() []string {
+	names := make([]string, 0, len(s.SecondaryCidrBlocks))
+	for name := range s.SecondaryCidrBlocks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// validateSubnetRanges checks that the primary and secondary ranges of the subnets don't overlap,
+// as required within a VPC network. Invalid ranges are reported by the validation of the subnets.
+func (n *NetworkSpec) validateSubnetRanges(fldPath *field.Path) field.ErrorList {
+	type subnetRange struct {
+		path *field.Path
+		cidr string
+		net  *net.IPNet
+	}
+
+	var allErrs field.ErrorList
+	var ranges []subnetRange
+	add := func(path *field.Path, cidr string) {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return
+		}
+
+		for _, r := range ranges {
+			if r.net.Contains(ipNet.IP) || ipNet.Contains(r.net.IP) {
+				allErrs = append(allErrs, field.Invalid(path, cidr, fmt.Sprintf("overlaps with %s (%s)", r.path, r.cidr)))
+			}
+		}
+		ranges = append(ranges, subnetRange{path: path, cidr: cidr, net: ipNet})
+	}
+
+	for i, subnet := range n.Subnets {
+		add(fldPath.Index(i).Child("cidrBlock"), subnet.CidrBlock)
+		for _, name := range subnet.secondaryRangeNames() {
+			add(fldPath.Index(i).Child("secondaryCidrBlocks").Key(name), subnet.SecondaryCidrBlocks[name])
+		}
+	}
+
+	return allErrs
+}
+
+func (p *PlacementPolicySpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if p.Collocation == PlacementPolicyCollocationCompact && p.AvailabilityDomainCount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("availabilityDomainCount"), fmt.Sprintf("is not supported with the %q collocation", p.Collocation)))
+	}
+
+	if p.Collocation != PlacementPolicyCollocationCompact && p.VMCount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("vmCount"), fmt.Sprintf("is only supported with the %q collocation", PlacementPolicyCollocationCompact)))
+	}
+
+	return allErrs
+}
+
+// newErrors returns the errors of errs that aren't in oldErrs, the errors of the object before an update.
+func newErrors(errs, oldErrs field.ErrorList) field.ErrorList {
+	old := make(map[string]bool, len(oldErrs))
+	for _, err := range oldErrs {
+		old[err.Error()] = true
+	}
+
+	var res field.ErrorList
+	for _, err := range errs {
+		if !old[err.Error()] {
+			res = append(res, err)
+		}
+	}
+
+	return res
+}
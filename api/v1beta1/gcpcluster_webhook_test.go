@@ -0,0 +1,606 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestGCPCluster_ValidateCreate(t *testing.T) {
+	dualStack := StackTypeIPv4IPv6
+	internal := IPv6AccessTypeInternal
+	tests := []struct {
+		name    string
+		spec    GCPClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "dual-stack subnet in a custom mode network (should succeed)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: Subnets{
+						{Name: "my-subnet", CidrBlock: "10.0.0.0/16", Region: "us-central1", StackType: &dualStack, IPv6AccessType: &internal},
+					},
+				},
+			},
+		},
+		{
+			name: "dual-stack subnet in an auto mode network (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(true),
+					Subnets: Subnets{
+						{Name: "my-subnet", CidrBlock: "10.0.0.0/16", Region: "us-central1", StackType: &dualStack, IPv6AccessType: &internal},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dual-stack subnet in a network of the default mode (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					Subnets: Subnets{
+						{Name: "my-subnet", CidrBlock: "10.0.0.0/16", Region: "us-central1", StackType: &dualStack, IPv6AccessType: &internal},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnets with distinct ranges (should succeed)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: Subnets{
+						{Name: "nodes", CidrBlock: "10.0.0.0/16", Region: "us-central1", SecondaryCidrBlocks: map[string]string{"pods": "10.1.0.0/16"}},
+						{Name: "control-plane", CidrBlock: "10.2.0.0/24", Region: "us-central1"},
+					},
+				},
+			},
+		},
+		{
+			name: "subnets with overlapping ranges (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: Subnets{
+						{Name: "nodes", CidrBlock: "10.0.0.0/16", Region: "us-central1"},
+						{Name: "control-plane", CidrBlock: "10.0.1.0/24", Region: "us-central1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary range overlapping a subnet (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: Subnets{
+						{Name: "nodes", CidrBlock: "10.0.0.0/16", Region: "us-central1", SecondaryCidrBlocks: map[string]string{"pods": "10.0.0.0/8"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid subnet range (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: NetworkSpec{
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: Subnets{
+						{Name: "nodes", CidrBlock: "10.0.0.0", Region: "us-central1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure domains in the region (should succeed)",
+			spec: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-central1-a", "us-central1-f"},
+			},
+		},
+		{
+			name: "failure domain in another region (should fail)",
+			spec: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-east1-b"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure domain of a region sharing the prefix of the region (should fail)",
+			spec: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-central1-a-b"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "region as failure domain (should fail)",
+			spec: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-central1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bastion in another region (should fail)",
+			spec: GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Bastion: &BastionSpec{Zone: pointer.String("europe-west1-b")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid labels (should succeed)",
+			spec: GCPClusterSpec{
+				Project:          "my-proj",
+				Region:           "us-central1",
+				AdditionalLabels: Labels{"team": "platform_1", "cost-center": ""},
+			},
+		},
+		{
+			name: "label key starting with a digit (should fail)",
+			spec: GCPClusterSpec{
+				Project:          "my-proj",
+				Region:           "us-central1",
+				AdditionalLabels: Labels{"1team": "platform"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "label key with uppercase letters (should fail)",
+			spec: GCPClusterSpec{
+				Project:          "my-proj",
+				Region:           "us-central1",
+				AdditionalLabels: Labels{"Team": "platform"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "label value with a dot (should fail)",
+			spec: GCPClusterSpec{
+				Project:          "my-proj",
+				Region:           "us-central1",
+				AdditionalLabels: Labels{"version": "1.21"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "label value too long (should fail)",
+			spec: GCPClusterSpec{
+				Project:          "my-proj",
+				Region:           "us-central1",
+				AdditionalLabels: Labels{"team": strings.Repeat("a", 64)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: tt.spec}
+			c.Default()
+			if err := c.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     GCPClusterSpec
+		new     GCPClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "recording the resource name prefix (should succeed)",
+			old:  GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			new:  GCPClusterSpec{Project: "my-proj", Region: "us-central1", ResourceNamePrefix: pointer.String("my-cluster-4f3c2a1b")},
+		},
+		{
+			name:    "changing the resource name prefix (should fail)",
+			old:     GCPClusterSpec{Project: "my-proj", Region: "us-central1", ResourceNamePrefix: pointer.String("my-cluster-4f3c2a1b")},
+			new:     GCPClusterSpec{Project: "my-proj", Region: "us-central1", ResourceNamePrefix: pointer.String("my-cluster")},
+			wantErr: true,
+		},
+		{
+			name:    "removing the resource name prefix (should fail)",
+			old:     GCPClusterSpec{Project: "my-proj", Region: "us-central1", ResourceNamePrefix: pointer.String("my-cluster-4f3c2a1b")},
+			new:     GCPClusterSpec{Project: "my-proj", Region: "us-central1"},
+			wantErr: true,
+		},
+		{
+			name: "updating a cluster accepted before its failure domains were validated (should succeed)",
+			old: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-east1-b"},
+			},
+			new: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-east1-b"},
+				Bastion:        &BastionSpec{AllowedCIDRBlocks: []string{"203.0.113.0/24"}},
+			},
+		},
+		{
+			name: "adding a failure domain in another region (should fail)",
+			old: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-central1-a"},
+			},
+			new: GCPClusterSpec{
+				Project:        "my-proj",
+				Region:         "us-central1",
+				FailureDomains: []string{"us-central1-a", "us-east1-b"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: tt.old}
+			c := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: tt.new}
+			old.Default()
+			c.Default()
+			if err := c.ValidateUpdate(old); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateCreate_Bastion(t *testing.T) {
+	tests := []struct {
+		name    string
+		bastion BastionSpec
+		wantErr bool
+	}{
+		{
+			name:    "bastion reachable over SSH from CIDR blocks (should succeed)",
+			bastion: BastionSpec{AccessMode: BastionAccessModeSSH, AllowedCIDRBlocks: []string{"203.0.113.0/24", "2001:db8::/32"}},
+		},
+		{
+			name:    "bastion reachable through IAP (should succeed)",
+			bastion: BastionSpec{AccessMode: BastionAccessModeIAP, Zone: pointer.String("us-central1-a")},
+		},
+		{
+			name:    "bastion with an invalid CIDR block (should fail)",
+			bastion: BastionSpec{AllowedCIDRBlocks: []string{"203.0.113.0"}},
+			wantErr: true,
+		},
+		{
+			name:    "bastion reachable through IAP with CIDR blocks (should fail)",
+			bastion: BastionSpec{AccessMode: BastionAccessModeIAP, AllowedCIDRBlocks: []string{"203.0.113.0/24"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bastion := tt.bastion
+			c := &GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec:       GCPClusterSpec{Project: "my-proj", Region: "us-central1", Bastion: &bastion},
+			}
+			c.Default()
+			if err := c.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateUpdate_Bastion(t *testing.T) {
+	bastion := &BastionSpec{
+		InstanceType:      "e2-micro",
+		Zone:              pointer.String("us-central1-a"),
+		AccessMode:        BastionAccessModeSSH,
+		AllowedCIDRBlocks: []string{"203.0.113.0/24"},
+	}
+	tests := []struct {
+		name    string
+		old     *BastionSpec
+		new     func(b *BastionSpec)
+		wantErr bool
+	}{
+		{
+			name: "changing the allowed CIDR blocks (should succeed)",
+			old:  bastion,
+			new:  func(b *BastionSpec) { b.AllowedCIDRBlocks = []string{"198.51.100.0/24"} },
+		},
+		{
+			name: "switching to IAP access (should succeed)",
+			old:  bastion,
+			new:  func(b *BastionSpec) { b.AccessMode, b.AllowedCIDRBlocks = BastionAccessModeIAP, nil },
+		},
+		{
+			name: "adding a bastion (should succeed)",
+			new:  func(b *BastionSpec) {},
+		},
+		{
+			name:    "changing the instance type (should fail)",
+			old:     bastion,
+			new:     func(b *BastionSpec) { b.InstanceType = "e2-small" },
+			wantErr: true,
+		},
+		{
+			name:    "changing the zone (should fail)",
+			old:     bastion,
+			new:     func(b *BastionSpec) { b.Zone = pointer.String("us-central1-b") },
+			wantErr: true,
+		},
+		{
+			name:    "adding a public IP (should fail)",
+			old:     bastion,
+			new:     func(b *BastionSpec) { b.PublicIP = pointer.Bool(true) },
+			wantErr: true,
+		},
+		{
+			name:    "adding an invalid CIDR block (should fail)",
+			old:     bastion,
+			new:     func(b *BastionSpec) { b.AllowedCIDRBlocks = append(b.AllowedCIDRBlocks, "198.51.100.0") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newBastion := bastion.DeepCopy()
+			tt.new(newBastion)
+			old := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: GCPClusterSpec{Project: "my-proj", Region: "us-central1", Bastion: tt.old.DeepCopy()}}
+			c := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: GCPClusterSpec{Project: "my-proj", Region: "us-central1", Bastion: newBastion}}
+			old.Default()
+			c.Default()
+			if err := c.ValidateUpdate(old); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateCreate_LoadBalancer(t *testing.T) {
+	standard := NetworkTierStandard
+	connection := BalancingModeConnection
+	utilization := BalancingModeUtilization
+	proxyV1 := ProxyHeaderProxyV1
+	clientIP := SessionAffinityClientIP
+	clientIPProto := SessionAffinityClientIPProto
+	tests := []struct {
+		name         string
+		networkTier  *NetworkTier
+		loadBalancer LoadBalancerSpec
+		wantErr      bool
+	}{
+		{
+			name:         "connection balancing mode with max connections (should succeed)",
+			loadBalancer: LoadBalancerSpec{BalancingMode: &connection, MaxConnectionsPerInstance: pointer.Int64(1000)},
+		},
+		{
+			name:         "connection balancing mode without max connections (should fail)",
+			loadBalancer: LoadBalancerSpec{BalancingMode: &connection},
+			wantErr:      true,
+		},
+		{
+			name:         "utilization balancing mode with max connections (should fail)",
+			loadBalancer: LoadBalancerSpec{BalancingMode: &utilization, MaxConnectionsPerInstance: pointer.Int64(1000)},
+			wantErr:      true,
+		},
+		{
+			name:         "max connections without balancing mode (should fail)",
+			loadBalancer: LoadBalancerSpec{MaxConnectionsPerInstance: pointer.Int64(1000)},
+			wantErr:      true,
+		},
+		{
+			name:         "proxy header and client IP session affinity (should succeed)",
+			loadBalancer: LoadBalancerSpec{ProxyHeader: &proxyV1, SessionAffinity: &clientIP},
+		},
+		{
+			name:         "session affinity not supported by the TCP proxy (should fail)",
+			loadBalancer: LoadBalancerSpec{SessionAffinity: &clientIPProto},
+			wantErr:      true,
+		},
+		{
+			name:         "connection balancing mode with the Standard network tier (should succeed)",
+			networkTier:  &standard,
+			loadBalancer: LoadBalancerSpec{BalancingMode: &connection, ConnectionDrainingTimeoutSec: pointer.Int64(30)},
+		},
+		{
+			name:         "proxy header with the Standard network tier (should fail)",
+			networkTier:  &standard,
+			loadBalancer: LoadBalancerSpec{ProxyHeader: &proxyV1},
+			wantErr:      true,
+		},
+		{
+			name:         "utilization balancing mode with the Standard network tier (should fail)",
+			networkTier:  &standard,
+			loadBalancer: LoadBalancerSpec{BalancingMode: &utilization},
+			wantErr:      true,
+		},
+		{
+			name:         "max connections with the Standard network tier (should fail)",
+			networkTier:  &standard,
+			loadBalancer: LoadBalancerSpec{BalancingMode: &connection, MaxConnectionsPerInstance: pointer.Int64(1000)},
+			wantErr:      true,
+		},
+		{
+			name:         "backend timeout with the Standard network tier (should fail)",
+			networkTier:  &standard,
+			loadBalancer: LoadBalancerSpec{BackendTimeoutSec: pointer.Int64(600)},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadBalancer := tt.loadBalancer
+			c := &GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec:       GCPClusterSpec{Project: "my-proj", Region: "us-central1", NetworkTier: tt.networkTier, LoadBalancer: &loadBalancer},
+			}
+			c.Default()
+			if err := c.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateUpdate_LoadBalancer(t *testing.T) {
+	none := ProxyHeaderNone
+	proxyV1 := ProxyHeaderProxyV1
+	tests := []struct {
+		name    string
+		old     *LoadBalancerSpec
+		new     *LoadBalancerSpec
+		wantErr bool
+	}{
+		{
+			name: "changing the backend timeout (should succeed)",
+			old:  &LoadBalancerSpec{BackendTimeoutSec: pointer.Int64(600)},
+			new:  &LoadBalancerSpec{BackendTimeoutSec: pointer.Int64(300)},
+		},
+		{
+			name: "setting the default proxy header (should succeed)",
+			new:  &LoadBalancerSpec{ProxyHeader: &none},
+		},
+		{
+			name:    "adding a proxy header (should fail)",
+			old:     &LoadBalancerSpec{},
+			new:     &LoadBalancerSpec{ProxyHeader: &proxyV1},
+			wantErr: true,
+		},
+		{
+			name:    "removing the proxy header (should fail)",
+			old:     &LoadBalancerSpec{ProxyHeader: &proxyV1},
+			wantErr: true,
+		},
+		{
+			name:    "changing the API server address (should fail)",
+			old:     &LoadBalancerSpec{APIServerAddress: pointer.String("my-address")},
+			new:     &LoadBalancerSpec{APIServerAddress: pointer.String("my-other-address")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: GCPClusterSpec{Project: "my-proj", Region: "us-central1", LoadBalancer: tt.old}}
+			c := &GCPCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"}, Spec: GCPClusterSpec{Project: "my-proj", Region: "us-central1", LoadBalancer: tt.new}}
+			old.Default()
+			c.Default()
+			if err := c.ValidateUpdate(old); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPCluster_ValidateCreate_ControlPlaneAccess(t *testing.T) {
+	dualStack := StackTypeIPv4IPv6
+	tests := []struct {
+		name               string
+		apiServerStackType *StackType
+		allowedCIDRs       []string
+		wantErr            bool
+	}{
+		{
+			name:         "distinct IPv4 ranges (should succeed)",
+			allowedCIDRs: []string{"203.0.113.0/24", "198.51.100.0/24"},
+		},
+		{
+			name:         "invalid range (should fail)",
+			allowedCIDRs: []string{"203.0.113.0"},
+			wantErr:      true,
+		},
+		{
+			name:         "range with an invalid prefix length (should fail)",
+			allowedCIDRs: []string{"203.0.113.0/33"},
+			wantErr:      true,
+		},
+		{
+			name:         "duplicate ranges (should fail)",
+			allowedCIDRs: []string{"203.0.113.0/24", "203.0.113.0/24"},
+			wantErr:      true,
+		},
+		{
+			name:         "range contained in another one (should fail)",
+			allowedCIDRs: []string{"203.0.113.0/24", "203.0.113.128/25"},
+			wantErr:      true,
+		},
+		{
+			name:               "IPv4 and IPv6 ranges with a dual-stack API server (should succeed)",
+			apiServerStackType: &dualStack,
+			allowedCIDRs:       []string{"203.0.113.0/24", "2001:db8::/32"},
+		},
+		{
+			name:         "IPv6 range with an IPv4 API server (should fail)",
+			allowedCIDRs: []string{"203.0.113.0/24", "2001:db8::/32"},
+			wantErr:      true,
+		},
+		{
+			name:               "overlapping IPv6 ranges (should fail)",
+			apiServerStackType: &dualStack,
+			allowedCIDRs:       []string{"2001:db8::/32", "2001:db8:1::/48"},
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				Spec: GCPClusterSpec{
+					Project:            "my-proj",
+					Region:             "us-central1",
+					Network:            NetworkSpec{APIServerStackType: tt.apiServerStackType},
+					ControlPlaneAccess: &ControlPlaneAccessSpec{AllowedCIDRs: tt.allowedCIDRs},
+				},
+			}
+			c.Default()
+			if err := c.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPCluster.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// clustertemplatelog is for logging in this package.
+var clustertemplatelog = logf.Log.WithName("gcpclustertemplate-resource")
+
+// SetupWebhookWithManager sets up and registers the webhook with the manager.
+func (r *GCPClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpclustertemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpclustertemplates,versions=v1beta1,name=validation.gcpclustertemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-gcpclustertemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpclustertemplates,versions=v1beta1,name=default.gcpclustertemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &GCPClusterTemplate{}
+var _ webhook.Defaulter = &GCPClusterTemplate{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+// Templates get the same defaults as GCPClusters, so that the clusters created from a
+// template don't drift from it once defaulted.
+func (r *GCPClusterTemplate) Default() {
+	clustertemplatelog.Info("default", "name", r.Name)
+
+	r.Spec.Template.Spec.setDefaults()
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *GCPClusterTemplate) ValidateCreate() error {
+	clustertemplatelog.Info("validate create", "name", r.Name)
+
+	fldPath := field.NewPath("spec", "template", "spec")
+	allErrs := r.Spec.Template.Spec.validate(fldPath)
+
+	// The prefix of the resource names must be unique to each cluster.
+	if r.Spec.Template.Spec.ResourceNamePrefix != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceNamePrefix"), "cannot be set in a GCPClusterTemplate"))
+	}
+
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPClusterTemplate").GroupKind(), r.Name, allErrs)
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+// Templates are immutable, so that the clusters created from a template all share the same spec.
+func (r *GCPClusterTemplate) ValidateUpdate(oldRaw runtime.Object) error {
+	clustertemplatelog.Info("validate update", "name", r.Name)
+	old := oldRaw.DeepCopyObject().(*GCPClusterTemplate)
+	old.Default()
+
+	if !reflect.DeepEqual(r.Spec, old.Spec) {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPClusterTemplate").GroupKind(), r.Name, field.ErrorList{
+			field.Forbidden(field.NewPath("spec"), "GCPClusterTemplate spec is immutable"),
+		})
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *GCPClusterTemplate) ValidateDelete() error {
+	clustertemplatelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,657 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/errors"
+)
+
+const (
+	// MachineFinalizer allows ReconcileGCPMachine to clean up GCP resources associated with GCPMachine before
+	// removing it from the apiserver.
+	MachineFinalizer = "gcpmachine.infrastructure.cluster.x-k8s.io"
+)
+
+// DiskType is a type to use to define with disk type will be used.
+type DiskType string
+
+const (
+	// PdStandardDiskType defines the name for the standard disk.
+	PdStandardDiskType DiskType = "pd-standard"
+	// PdSsdDiskType defines the name for the ssd disk.
+	PdSsdDiskType DiskType = "pd-ssd"
+	// PdBalancedDiskType defines the name for the balanced disk.
+	PdBalancedDiskType DiskType = "pd-balanced"
+	// PdExtremeDiskType defines the name for the extreme disk, supporting provisioned IOPS.
+	PdExtremeDiskType DiskType = "pd-extreme"
+	// HyperdiskBalancedDiskType defines the name for the balanced hyperdisk, supporting provisioned IOPS.
+	HyperdiskBalancedDiskType DiskType = "hyperdisk-balanced"
+	// HyperdiskExtremeDiskType defines the name for the extreme hyperdisk, supporting provisioned IOPS.
+	HyperdiskExtremeDiskType DiskType = "hyperdisk-extreme"
+	// HyperdiskThroughputDiskType defines the name for the throughput optimized hyperdisk.
+	HyperdiskThroughputDiskType DiskType = "hyperdisk-throughput"
+	// LocalSsdDiskType defines the name for the local ssd disk.
+	LocalSsdDiskType DiskType = "local-ssd"
+)
+
+// SupportsProvisionedIOPS returns true if disks of this type can have provisioned IOPS.
+func (t DiskType) SupportsProvisionedIOPS() bool {
+	switch t {
+	case PdExtremeDiskType, HyperdiskBalancedDiskType, HyperdiskExtremeDiskType:
+		return true
+	default:
+		return false
+	}
+}
+
+// BootstrapDataStorage defines where the bootstrap data of an instance is stored.
+// +kubebuilder:validation:Enum=Metadata;SecretManager;GCS
+type BootstrapDataStorage string
+
+const (
+	// BootstrapDataStorageMetadata stores the bootstrap data in the "user-data" key of the instance metadata.
+	BootstrapDataStorageMetadata BootstrapDataStorage = "Metadata"
+	// BootstrapDataStorageSecretManager stores the bootstrap data in a Secret Manager secret only readable by the
+	// instance service account. The instance metadata only contains a script fetching it.
+	BootstrapDataStorageSecretManager BootstrapDataStorage = "SecretManager"
+	// BootstrapDataStorageGCS stores the bootstrap data in an object of a GCS bucket only readable by the
+	// instance service account. The instance metadata only contains a script, or an Ignition config, fetching it.
+	BootstrapDataStorageGCS BootstrapDataStorage = "GCS"
+)
+
+// AttachedDiskSpec degined GCP machine disk.
+type AttachedDiskSpec struct {
+	// DeviceType is a device type of the attached disk.
+	// Supported types of non-root attached volumes:
+	// 1. "pd-standard" - Standard (HDD) persistent disk
+	// 2. "pd-ssd" - SSD persistent disk
+	// 3. "pd-balanced" - Balanced persistent disk
+	// 4. "pd-extreme" - Extreme persistent disk, with provisioned IOPS
+	// 5. "hyperdisk-balanced", "hyperdisk-extreme" and "hyperdisk-throughput" - Hyperdisk volumes
+	// 6. "local-ssd" - Local SSD disk (https://cloud.google.com/compute/docs/disks/local-ssd).
+	// Default is "pd-standard".
+	// +kubebuilder:validation:Enum=pd-standard;pd-ssd;pd-balanced;pd-extreme;hyperdisk-balanced;hyperdisk-extreme;hyperdisk-throughput;local-ssd
+	// +optional
+	DeviceType *DiskType `json:"deviceType,omitempty"`
+	// Size is the size of the disk in GBs.
+	// Defaults to 30GB. For "local-ssd" size is always 375GB.
+	// +optional
+	Size *int64 `json:"size,omitempty"`
+	// Name is the name of the disk. Defaults to a name generated by GCP.
+	// Not supported for "local-ssd".
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// ProvisionedIOPS is the number of I/O operations per second the disk can handle.
+	// Only supported for "pd-extreme", "hyperdisk-balanced" and "hyperdisk-extreme".
+	// +optional
+	ProvisionedIOPS *int64 `json:"provisionedIOPS,omitempty"`
+	// SourceSnapshot is the full reference to the snapshot the disk is created from.
+	// Not supported for "local-ssd", and mutually exclusive with SourceImage.
+	// +optional
+	SourceSnapshot *string `json:"sourceSnapshot,omitempty"`
+	// SourceImage is the full reference to the image the disk is created from.
+	// Not supported for "local-ssd", and mutually exclusive with SourceSnapshot.
+	// +optional
+	SourceImage *string `json:"sourceImage,omitempty"`
+	// Labels is an optional set of labels to add to the disk, in addition to the labels of the instance.
+	// Not supported for "local-ssd".
+	// +optional
+	Labels Labels `json:"labels,omitempty"`
+	// AutoDelete defines if the disk is deleted with the instance. Defaults to true.
+	// Local SSDs are always deleted with the instance.
+	// +optional
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+	// ResourcePolicies are the full references to existing resource policies, such as snapshot
+	// schedules, attached to the disk. Not supported for "local-ssd".
+	// +optional
+	ResourcePolicies []string `json:"resourcePolicies,omitempty"`
+	// Count is the number of disks to attach. Only supported for "local-ssd", defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+}
+
+// NodeAffinityOperator defines how a sole-tenant node affinity matches the node labels.
+// +kubebuilder:validation:Enum=In;NotIn
+type NodeAffinityOperator string
+
+const (
+	// NodeAffinityOperatorIn schedules the instance on nodes with one of the values for the key.
+	NodeAffinityOperatorIn NodeAffinityOperator = "In"
+	// NodeAffinityOperatorNotIn schedules the instance on nodes with none of the values for the key.
+	NodeAffinityOperatorNotIn NodeAffinityOperator = "NotIn"
+)
+
+// NodeAffinity defines a sole-tenant node affinity of an instance.
+type NodeAffinity struct {
+	// Key is the node affinity label key, e.g. "compute.googleapis.com/node-group-name".
+	// Mutually exclusive with NodeGroup.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Operator defines how the values of the key are matched. Defaults to "In".
+	// +optional
+	Operator NodeAffinityOperator `json:"operator,omitempty"`
+
+	// Values are the node affinity label values of the key.
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// NodeGroup is the name of a sole-tenant node group the instance is scheduled on.
+	// It is a shorthand for the "compute.googleapis.com/node-group-name" key, and is
+	// mutually exclusive with Key and Values.
+	// +optional
+	NodeGroup *string `json:"nodeGroup,omitempty"`
+}
+
+// ReservationAffinityType defines which reservations an instance consumes.
+// +kubebuilder:validation:Enum=Any;Specific;None
+type ReservationAffinityType string
+
+const (
+	// ReservationAffinityTypeAny consumes any matching reservation.
+	ReservationAffinityTypeAny ReservationAffinityType = "Any"
+	// ReservationAffinityTypeSpecific only consumes the given reservations.
+	ReservationAffinityTypeSpecific ReservationAffinityType = "Specific"
+	// ReservationAffinityTypeNone doesn't consume reservations.
+	ReservationAffinityTypeNone ReservationAffinityType = "None"
+)
+
+// CustomMachineTypeSeries is the machine series of a custom machine type.
+// +kubebuilder:validation:Enum=n1;n2;n2d;e2
+type CustomMachineTypeSeries string
+
+const (
+	// CustomMachineTypeSeriesN1 is the N1 machine series.
+	CustomMachineTypeSeriesN1 = CustomMachineTypeSeries("n1")
+	// CustomMachineTypeSeriesN2 is the N2 machine series.
+	CustomMachineTypeSeriesN2 = CustomMachineTypeSeries("n2")
+	// CustomMachineTypeSeriesN2D is the N2D machine series.
+	CustomMachineTypeSeriesN2D = CustomMachineTypeSeries("n2d")
+	// CustomMachineTypeSeriesE2 is the E2 machine series.
+	CustomMachineTypeSeriesE2 = CustomMachineTypeSeries("e2")
+)
+
+// CustomMachineType defines the shape of a custom machine type.
+type CustomMachineType struct {
+	// Series is the machine series of the custom machine type.
+	// Defaults to "n1".
+	// +optional
+	Series CustomMachineTypeSeries `json:"series,omitempty"`
+
+	// CPUs is the number of vCPUs.
+	// +kubebuilder:validation:Minimum=1
+	CPUs int64 `json:"cpus"`
+
+	// MemoryMB is the amount of memory in MB, a multiple of 256.
+	// +kubebuilder:validation:Minimum=256
+	MemoryMB int64 `json:"memoryMB"`
+
+	// ExtendedMemory allows more memory per vCPU than the series supports, billed as extended memory.
+	// Not supported by the E2 series.
+	// +optional
+	ExtendedMemory bool `json:"extendedMemory,omitempty"`
+}
+
+// AdvancedMachineFeatures configures the CPU of an instance.
+type AdvancedMachineFeatures struct {
+	// ThreadsPerCore is the number of threads per physical core, 1 to disable simultaneous multithreading.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	ThreadsPerCore *int64 `json:"threadsPerCore,omitempty"`
+
+	// EnableNestedVirtualization enables nested virtualization on the instance.
+	// +optional
+	EnableNestedVirtualization bool `json:"enableNestedVirtualization,omitempty"`
+}
+
+// GuestAccelerator defines accelerators of the same type attached to an instance.
+type GuestAccelerator struct {
+	// Type is the type of the accelerators, e.g. "nvidia-tesla-t4".
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// Count is the number of accelerators.
+	// +kubebuilder:validation:Minimum=1
+	Count int64 `json:"count"`
+}
+
+// NicType is the type of virtual network interface card.
+// +kubebuilder:validation:Enum=GVNIC;VIRTIO_NET
+type NicType string
+
+const (
+	// NicTypeGVNIC is the Google Virtual NIC.
+	NicTypeGVNIC = NicType("GVNIC")
+	// NicTypeVirtioNet is the VirtIO network driver.
+	NicTypeVirtioNet = NicType("VIRTIO_NET")
+)
+
+// NetworkInterface defines a network interface of an instance.
+type NetworkInterface struct {
+	// Network is the name of the network the interface is connected to.
+	// Defaults to the network of the GCPCluster.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// Subnet is the name of the subnetwork, in the region of the GCPCluster, the interface is connected to.
+	// Defaults to the subnetwork of the network in the region of the GCPCluster.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// NetworkIP is a static internal IP address of the interface.
+	// Defaults to an ephemeral internal IP address.
+	// +optional
+	NetworkIP *string `json:"networkIP,omitempty"`
+
+	// AliasIPRanges are alias IP ranges of the interface, e.g. VPC-native pod CIDRs.
+	// +optional
+	AliasIPRanges []AliasIPRange `json:"aliasIPRanges,omitempty"`
+
+	// PublicIP specifies whether the interface gets an external IP address.
+	// +optional
+	PublicIP *bool `json:"publicIP,omitempty"`
+
+	// NicType is the type of virtual network interface card of the interface.
+	// +optional
+	NicType *NicType `json:"nicType,omitempty"`
+
+	// StackType is the IP stack of the interface. IPV4_IPV6 requires a dual-stack subnetwork.
+	// Defaults to IPV4_ONLY.
+	// +optional
+	StackType *StackType `json:"stackType,omitempty"`
+
+	// IPv6PublicIP specifies whether the interface gets an external IPv6 address, which requires
+	// a dual-stack subnetwork with the EXTERNAL IPv6 access type.
+	// +optional
+	IPv6PublicIP *bool `json:"ipv6PublicIP,omitempty"`
+}
+
+// AliasIPRange defines an alias IP range of a network interface.
+type AliasIPRange struct {
+	// IPCidrRange is the IP CIDR range, either an address range like "10.2.3.0/24",
+	// or a netmask like "/24" allocated from the subnetwork range.
+	IPCidrRange string `json:"ipCidrRange"`
+
+	// SubnetworkRangeName is the name of the secondary range of the subnetwork to allocate the range from.
+	// Defaults to the primary range of the subnetwork.
+	// +optional
+	SubnetworkRangeName *string `json:"subnetworkRangeName,omitempty"`
+}
+
+// AddressReservations defines the static addresses reserved for a GCPMachine, owned by the
+// GCPMachine and released when it is deleted.
+type AddressReservations struct {
+	// Internal reserves a static internal address bound to the primary network interface.
+	// Requires the subnetwork of the primary network interface to be set.
+	// +optional
+	Internal *AddressReservation `json:"internal,omitempty"`
+
+	// External reserves a static external address bound to the access config of the primary
+	// network interface, which gets an external address even when PublicIP isn't set.
+	// +optional
+	External *AddressReservation `json:"external,omitempty"`
+}
+
+// AddressReservation defines a static address reserved for a GCPMachine.
+type AddressReservation struct {
+	// Address is the IP address to reserve.
+	// Defaults to an available address.
+	// +optional
+	Address *string `json:"address,omitempty"`
+
+	// Preserve keeps the reserved address when the instance is recreated. Otherwise the address
+	// is released and a new one is reserved for the new instance.
+	// +optional
+	Preserve bool `json:"preserve,omitempty"`
+}
+
+// ReservationAffinity defines the reservations an instance consumes.
+type ReservationAffinity struct {
+	// Type defines which reservations the instance consumes.
+	Type ReservationAffinityType `json:"type"`
+
+	// Reservations are the names of the reservations the instance consumes.
+	// Required when Type is "Specific", and forbidden otherwise.
+	// +optional
+	Reservations []string `json:"reservations,omitempty"`
+}
+
+// ImageLookup defines how to look up the image of a machine with the Images API.
+// FamilyTemplate and NameFilter are Go templates which can use {{.Project}}, {{.BaseOS}},
+// {{.KubernetesVersion}} (e.g. "v1-21-2") and {{.KubernetesMinorVersion}} (e.g. "v1-21").
+type ImageLookup struct {
+	// Project is the project the images are looked up in.
+	// Defaults to the project of the cluster.
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// FamilyTemplate renders the family of the images, e.g. "capi-{{.BaseOS}}-k8s-{{.KubernetesMinorVersion}}".
+	// Defaults to "capi-{{.BaseOS}}-k8s-{{.KubernetesMinorVersion}}" when neither NameFilter nor Labels are set.
+	// +optional
+	FamilyTemplate *string `json:"familyTemplate,omitempty"`
+
+	// NameFilter renders a regular expression the whole name of the images must match.
+	// Defaults to ".*-{{.KubernetesVersion}}(-.*)?" when the Machine has a Kubernetes version.
+	// +optional
+	NameFilter *string `json:"nameFilter,omitempty"`
+
+	// Labels are labels the images must have.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// BaseOS is the OS flavor of the images, e.g. "ubuntu-2004" or "flatcar-stable".
+	// Defaults to "ubuntu-1804".
+	// +optional
+	BaseOS *string `json:"baseOS,omitempty"`
+}
+
+// GCPMachineSpec defines the desired state of GCPMachine.
+type GCPMachineSpec struct {
+	// InstanceType is the type of instance to create. Example: n1-standard-2.
+	// Required unless CustomMachineType is set.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// CustomMachineType is the shape of a custom machine type to create the instance with,
+	// mutually exclusive with InstanceType.
+	// +optional
+	CustomMachineType *CustomMachineType `json:"customMachineType,omitempty"`
+
+	// MinCPUPlatform is the minimum CPU platform of the instance, e.g. "Intel Cascade Lake".
+	// +optional
+	MinCPUPlatform *string `json:"minCPUPlatform,omitempty"`
+
+	// AdvancedMachineFeatures configures the CPU of the instance.
+	// +optional
+	AdvancedMachineFeatures *AdvancedMachineFeatures `json:"advancedMachineFeatures,omitempty"`
+
+	// GuestAccelerators are the accelerators, e.g. GPUs, attached to the instance.
+	// Instances with guest accelerators are terminated on host maintenance events.
+	// +optional
+	GuestAccelerators []GuestAccelerator `json:"guestAccelerators,omitempty"`
+
+	// Subnet is a reference to the subnetwork to use for this instance. If not specified,
+	// the first subnetwork retrieved from the Cluster Region and Network is picked.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// NetworkInterfaces are the network interfaces of the instance, the first one being the primary interface.
+	// Mutually exclusive with Subnet and PublicIP. Defaults to a single interface connected to the network
+	// of the GCPCluster, configured by Subnet and PublicIP.
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
+
+	// NetworkTier is the network tier of the external addresses of the instance.
+	// Defaults to the network tier of the GCPCluster.
+	// +optional
+	NetworkTier *NetworkTier `json:"networkTier,omitempty"`
+
+	// AddressReservations reserves static addresses for the primary network interface of the instance.
+	// +optional
+	AddressReservations *AddressReservations `json:"addressReservations,omitempty"`
+
+	// ProviderID is the unique identifier as specified by the cloud provider.
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// FailureDomain is the zone the instance has been placed in. It is set by the provider
+	// when the owning Machine doesn't specify a failure domain, or when the instance had to
+	// be created in another zone because of FailureDomainFallback. It can't be changed once
+	// the instance is created.
+	// +optional
+	FailureDomain *string `json:"failureDomain,omitempty"`
+
+	// FailureDomainFallback enables retrying the instance creation in another failure domain
+	// of the cluster when the selected zone doesn't have enough resources available
+	// (ZONE_RESOURCE_POOL_EXHAUSTED).
+	// +optional
+	FailureDomainFallback bool `json:"failureDomainFallback,omitempty"`
+
+	// ImageFamily is the full reference to a valid image family to be used for this machine.
+	// +optional
+	ImageFamily *string `json:"imageFamily,omitempty"`
+
+	// Image is the full reference to a valid image to be used for this machine.
+	// Takes precedence over ImageFamily.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// ImageLookup defines how to look up the newest image matching the Kubernetes version of the Machine
+	// when neither Image nor ImageFamily is set. The resolved image is recorded in the status, and shared
+	// by the machines of the same MachineDeployment rollout. Takes precedence over ImageFamilyTemplate.
+	// +optional
+	ImageLookup *ImageLookup `json:"imageLookup,omitempty"`
+
+	// ImageFamilyTemplate is a Go template rendering the full reference to the image family
+	// used when neither Image nor ImageFamily is set. The template can use
+	// {{.Project}}, the project of the cluster, {{.KubernetesVersion}}, the Kubernetes
+	// version of the Machine with dashes (e.g. "v1-21-2"), and {{.KubernetesMinorVersion}},
+	// the Kubernetes minor version of the Machine with dashes (e.g. "v1-21").
+	// Example for Flatcar: "projects/{{.Project}}/global/images/family/capi-flatcar-stable-k8s-{{.KubernetesMinorVersion}}".
+	// Defaults to "projects/{{.Project}}/global/images/family/capi-ubuntu-1804-k8s-{{.KubernetesMinorVersion}}".
+	// +optional
+	ImageFamilyTemplate *string `json:"imageFamilyTemplate,omitempty"`
+
+	// AdditionalLabels is an optional set of tags to add to an instance, in addition to the ones added by default by the
+	// GCP provider. If both the GCPCluster and the GCPMachine specify the same tag name with different values, the
+	// GCPMachine's value takes precedence.
+	// +optional
+	AdditionalLabels Labels `json:"additionalLabels,omitempty"`
+
+	// AdditionalMetadata is an optional set of metadata to add to an instance, in addition to the ones added by default by the
+	// GCP provider.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	AdditionalMetadata []MetadataItem `json:"additionalMetadata,omitempty"`
+
+	// IAMInstanceProfile is a name of an IAM instance profile to assign to the instance
+	// +optional
+	// IAMInstanceProfile string `json:"iamInstanceProfile,omitempty"`
+
+	// PublicIP specifies whether the instance should get a public IP.
+	// Set this to true if you don't have a NAT instances or Cloud Nat setup.
+	// +optional
+	PublicIP *bool `json:"publicIP,omitempty"`
+
+	// AdditionalNetworkTags is a list of network tags that should be applied to the
+	// instance. These tags are set in addition to any network tags defined
+	// at the cluster level or in the actuator.
+	// +optional
+	AdditionalNetworkTags []string `json:"additionalNetworkTags,omitempty"`
+
+	// RootDeviceSize is the size of the root volume in GB.
+	// Defaults to 30.
+	// +optional
+	RootDeviceSize int64 `json:"rootDeviceSize,omitempty"`
+
+	// RootDeviceType is the type of the root volume.
+	// Supported types of root volumes:
+	// 1. "pd-standard" - Standard (HDD) persistent disk
+	// 2. "pd-ssd" - SSD persistent disk
+	// 3. "pd-balanced" - Balanced persistent disk
+	// 4. "pd-extreme" - Extreme persistent disk
+	// 5. "hyperdisk-balanced" - Balanced hyperdisk
+	// Default is "pd-standard".
+	// +optional
+	RootDeviceType *DiskType `json:"rootDeviceType,omitempty"`
+
+	// AdditionalDisks are optional non-boot attached disks.
+	// +optional
+	AdditionalDisks []AttachedDiskSpec `json:"additionalDisks,omitempty"`
+
+	// ServiceAccount specifies the service account email and which scopes to assign to the machine.
+	// Defaults to: email: "default", scope: []{compute.CloudPlatformScope}
+	// +optional
+	ServiceAccount *ServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// Preemptible defines if instance is preemptible
+	// +optional
+	Preemptible bool `json:"preemptible,omitempty"`
+
+	// NodeAffinities schedules the instance on sole-tenant nodes matching all the affinities.
+	// +optional
+	NodeAffinities []NodeAffinity `json:"nodeAffinities,omitempty"`
+
+	// PlacementPolicy is the name of a placement policy of the GCPCluster the instance is attached to.
+	// Instances attached to a "Compact" placement policy are terminated on host maintenance events.
+	// +optional
+	PlacementPolicy *string `json:"placementPolicy,omitempty"`
+
+	// ReservationAffinity defines the reservations the instance consumes.
+	// Defaults to consuming any matching reservation.
+	// +optional
+	ReservationAffinity *ReservationAffinity `json:"reservationAffinity,omitempty"`
+
+	// BootstrapDataStorage defines where the bootstrap data of the instance is stored.
+	// When set to "SecretManager" or "GCS", the stored data is deleted once the Machine has a NodeRef.
+	// "SecretManager" only supports cloud-config bootstrap data, Ignition bootstrap data requires "GCS".
+	// Defaults to "Metadata".
+	// +optional
+	BootstrapDataStorage BootstrapDataStorage `json:"bootstrapDataStorage,omitempty"`
+
+	// BootstrapDataBucket is the name of the GCS bucket storing the bootstrap data when
+	// BootstrapDataStorage is "GCS". The bucket must use fine-grained access control, as
+	// access to each object is only granted to the service account of its instance.
+	// +optional
+	BootstrapDataBucket *string `json:"bootstrapDataBucket,omitempty"`
+}
+
+// MetadataItem defines a single piece of metadata associated with an instance.
+type MetadataItem struct {
+	// Key is the identifier for the metadata entry.
+	Key string `json:"key"`
+	// Value is the value of the metadata entry.
+	Value *string `json:"value,omitempty"`
+}
+
+// GCPMachineStatus defines the observed state of GCPMachine.
+type GCPMachineStatus struct {
+	// Ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Addresses contains the GCP instance associated addresses.
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+
+	// InstanceStatus is the status of the GCP instance for this machine.
+	// +optional
+	InstanceStatus *InstanceStatus `json:"instanceState,omitempty"`
+
+	// InstanceName is the name of the GCP instance for this machine, recorded when the machine
+	// is first reconciled so that the instance is never orphaned. Once the instance is created,
+	// it is also the last segment of the provider ID, which is used when the status is lost.
+	// +optional
+	InstanceName *string `json:"instanceName,omitempty"`
+
+	// Image is the full reference to the image resolved by the image lookup of the machine.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// BootstrapDataSecret is the full reference to the Secret Manager secret
+	// holding the bootstrap data of the instance, until it gets deleted.
+	// +optional
+	BootstrapDataSecret *string `json:"bootstrapDataSecret,omitempty"`
+
+	// BootstrapDataObject is the gs:// URL of the GCS object holding the
+	// bootstrap data of the instance, until it gets deleted.
+	// +optional
+	BootstrapDataObject *string `json:"bootstrapDataObject,omitempty"`
+
+	// ReservedInternalAddress is the static internal address reserved for the instance.
+	// +optional
+	ReservedInternalAddress *string `json:"reservedInternalAddress,omitempty"`
+
+	// ReservedExternalAddress is the static external address reserved for the instance.
+	// +optional
+	ReservedExternalAddress *string `json:"reservedExternalAddress,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a succinct value suitable
+	// for machine interpretation.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Machine's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Machines
+	// can be added as events to the Machine object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureReason *errors.MachineStatusError `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the Machine and will contain a more verbose string suitable
+	// for logging and human consumption.
+	//
+	// This field should not be set for transitive errors that a controller
+	// faces that are expected to be fixed automatically over
+	// time (like service outages), but instead indicate that something is
+	// fundamentally wrong with the Machine's spec or the configuration of
+	// the controller, and that manual intervention is required. Examples
+	// of terminal errors would be invalid combinations of settings in the
+	// spec, values that are unsupported by the controller, or the
+	// responsible controller itself being critically misconfigured.
+	//
+	// Any transient errors that occur during the reconciliation of Machines
+	// can be added as events to the Machine object and/or logged in the
+	// controller's output.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=gcpmachines,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this GCPMachine belongs"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceState",description="GCE instance state"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine ready status"
+// +kubebuilder:printcolumn:name="InstanceID",type="string",JSONPath=".spec.providerID",description="GCE instance ID"
+// +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".metadata.ownerReferences[?(@.kind==\"Machine\")].name",description="Machine object which owns with this GCPMachine"
+
+// GCPMachine is the Schema for the gcpmachines API.
+type GCPMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPMachineSpec   `json:"spec,omitempty"`
+	Status GCPMachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPMachineList contains a list of GCPMachine.
+type GCPMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPMachine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPMachine{}, &GCPMachineList{})
+}
@@ -0,0 +1,498 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// machinelog is for logging in this package.
+var machinelog = logf.Log.WithName("gcpmachine-resource")
+
+const (
+	// defaultRootDeviceSize is the default size in GB of the root volume.
+	defaultRootDeviceSize = 30
+	// defaultServiceAccountEmail selects the Compute Engine default service account of the project.
+	defaultServiceAccountEmail = "default"
+	// cloudPlatformScope is the OAuth scope giving access to all the Google Cloud APIs allowed by IAM.
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	// maxLabels is the maximum number of labels of a GCP resource.
+	maxLabels = 64
+	// maxLabelLength is the maximum length of the keys and the values of GCP labels.
+	maxLabelLength = 63
+)
+
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[a-z][-_a-z0-9]*$`)
+	labelValueRegexp = regexp.MustCompile(`^[-_a-z0-9]*$`)
+
+	// reservedMetadataKeys are the instance metadata keys set by the provider.
+	reservedMetadataKeys = map[string]bool{
+		"user-data": true,
+	}
+)
+
+func (m *GCPMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(m).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachine,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachines,versions=v1beta1,name=validation.gcpmachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachine,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachines,versions=v1beta1,name=default.gcpmachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &GCPMachine{}
+var _ webhook.Defaulter = &GCPMachine{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (m *GCPMachine) ValidateCreate() error {
+	machinelog.Info("validate create", "name", m.Name)
+
+	allErrs := m.Spec.validate(field.NewPath("spec"))
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachine").GroupKind(), m.Name, allErrs)
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (m *GCPMachine) ValidateUpdate(old runtime.Object) error {
+	machinelog.Info("validate update", "name", m.Name)
+
+	// GCPMachines stored before their defaults were materialized get defaulted on update.
+	old = old.DeepCopyObject()
+	if oldGCPMachine, ok := old.(*GCPMachine); ok {
+		oldGCPMachine.Default()
+	}
+
+	newGCPMachine, err := runtime.DefaultUnstructuredConverter.ToUnstructured(m)
+	if err != nil {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachine").GroupKind(), m.Name, field.ErrorList{
+			field.InternalError(nil, errors.Wrap(err, "failed to convert new GCPMachine to unstructured object")),
+		})
+	}
+	oldGCPMachine, err := runtime.DefaultUnstructuredConverter.ToUnstructured(old)
+	if err != nil {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachine").GroupKind(), m.Name, field.ErrorList{
+			field.InternalError(nil, errors.Wrap(err, "failed to convert old GCPMachine to unstructured object")),
+		})
+	}
+
+	newGCPMachineSpec := newGCPMachine["spec"].(map[string]interface{})
+	oldGCPMachineSpec := oldGCPMachine["spec"].(map[string]interface{})
+
+	// allow changes to failureDomain until the instance is created, as the instance
+	// would otherwise be orphaned in its zone
+	_, hasFailureDomain := oldGCPMachineSpec["failureDomain"]
+	_, hasProviderID := oldGCPMachineSpec["providerID"]
+	if !hasFailureDomain || !hasProviderID {
+		delete(oldGCPMachineSpec, "failureDomain")
+		delete(newGCPMachineSpec, "failureDomain")
+	}
+
+	// allow changes to providerID
+	delete(oldGCPMachineSpec, "providerID")
+	delete(newGCPMachineSpec, "providerID")
+
+	// allow changes to additionalLabels
+	delete(oldGCPMachineSpec, "additionalLabels")
+	delete(newGCPMachineSpec, "additionalLabels")
+
+	// allow changes to additionalNetworkTags
+	delete(oldGCPMachineSpec, "additionalNetworkTags")
+	delete(newGCPMachineSpec, "additionalNetworkTags")
+
+	if !reflect.DeepEqual(oldGCPMachineSpec, newGCPMachineSpec) {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachine").GroupKind(), m.Name, field.ErrorList{
+			field.Forbidden(field.NewPath("spec"), "cannot be modified"),
+		})
+	}
+
+	// Only the errors introduced by the update are reported, so that machines accepted before
+	// the labels were validated can still be updated, e.g. to remove their finalizer.
+	var oldLabels Labels
+	if oldGCPMachine, ok := old.(*GCPMachine); ok {
+		oldLabels = oldGCPMachine.Spec.AdditionalLabels
+	}
+	fldPath := field.NewPath("spec", "additionalLabels")
+	if allErrs := newErrors(m.Spec.AdditionalLabels.validate(fldPath), oldLabels.validate(fldPath)); len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachine").GroupKind(), m.Name, allErrs)
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (m *GCPMachine) ValidateDelete() error {
+	machinelog.Info("validate delete", "name", m.Name)
+
+	return nil
+}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (m *GCPMachine) Default() {
+	machinelog.Info("default", "name", m.Name)
+
+	m.Spec.setDefaults()
+}
+
+// setDefaults sets the default values of a GCPMachineSpec.
+func (s *GCPMachineSpec) setDefaults() {
+	if s.RootDeviceSize == 0 {
+		s.RootDeviceSize = defaultRootDeviceSize
+	}
+
+	if s.RootDeviceType == nil {
+		diskType := PdStandardDiskType
+		s.RootDeviceType = &diskType
+	}
+
+	if s.ServiceAccount == nil {
+		s.ServiceAccount = &ServiceAccount{
+			Email:  defaultServiceAccountEmail,
+			Scopes: []string{cloudPlatformScope},
+		}
+	}
+}
+
+// validate validates the content of a GCPMachineSpec, shared by GCPMachines and GCPMachineTemplates.
+func (s *GCPMachineSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case s.CustomMachineType != nil && s.InstanceType != "":
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("customMachineType"), "is mutually exclusive with instanceType"))
+	case s.CustomMachineType != nil:
+		allErrs = append(allErrs, s.CustomMachineType.validate(fldPath.Child("customMachineType"))...)
+	case s.InstanceType == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("instanceType"), "either instanceType or customMachineType is required"))
+	}
+
+	if s.BootstrapDataStorage == BootstrapDataStorageGCS && s.BootstrapDataBucket == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bootstrapDataBucket"), "is required when bootstrapDataStorage is GCS"))
+	}
+
+	if t := s.ImageFamilyTemplate; t != nil {
+		if _, err := RenderImageTemplate(*t, "project", DefaultImageLookupBaseOS, "v1.21.0"); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("imageFamilyTemplate"), *t, err.Error()))
+		}
+	}
+
+	if l := s.ImageLookup; l != nil {
+		allErrs = append(allErrs, l.validate(fldPath.Child("imageLookup"))...)
+	}
+
+	if len(s.NetworkInterfaces) > 0 {
+		if s.Subnet != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnet"), "is mutually exclusive with networkInterfaces"))
+		}
+		if s.PublicIP != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("publicIP"), "is mutually exclusive with networkInterfaces"))
+		}
+	}
+
+	networks := make(map[string]bool, len(s.NetworkInterfaces))
+	for i := range s.NetworkInterfaces {
+		nic := &s.NetworkInterfaces[i]
+		allErrs = append(allErrs, nic.validate(fldPath.Child("networkInterfaces").Index(i))...)
+
+		network := ""
+		if nic.Network != nil {
+			network = *nic.Network
+		}
+		if networks[network] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("networkInterfaces").Index(i).Child("network"), network))
+		}
+		networks[network] = true
+	}
+
+	if s.AddressReservations != nil {
+		allErrs = append(allErrs, s.validateAddressReservations(fldPath.Child("addressReservations"))...)
+	}
+
+	allErrs = append(allErrs, s.AdditionalLabels.validate(fldPath.Child("additionalLabels"))...)
+
+	keys := make(map[string]bool, len(s.AdditionalMetadata))
+	for i, item := range s.AdditionalMetadata {
+		keyPath := fldPath.Child("additionalMetadata").Index(i).Child("key")
+		if reservedMetadataKeys[item.Key] {
+			allErrs = append(allErrs, field.Forbidden(keyPath, fmt.Sprintf("%q is set by the provider", item.Key)))
+		}
+		if keys[item.Key] {
+			allErrs = append(allErrs, field.Duplicate(keyPath, item.Key))
+		}
+		keys[item.Key] = true
+	}
+
+	if sa := s.ServiceAccount; sa != nil && sa.Email == "" && len(sa.Scopes) > 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccounts", "email"), "is required with scopes, use \"default\" for the Compute Engine default service account"))
+	}
+
+	allErrs = append(allErrs, validateRootDeviceType(s.RootDeviceType, fldPath.Child("rootDeviceType"))...)
+	if s.RootDeviceSize != 0 {
+		rootDeviceType := PdStandardDiskType
+		if s.RootDeviceType != nil {
+			rootDeviceType = *s.RootDeviceType
+		}
+		allErrs = append(allErrs, validateDiskSize(rootDeviceType, s.RootDeviceSize, fldPath.Child("rootDeviceSize"))...)
+	}
+	for i := range s.AdditionalDisks {
+		allErrs = append(allErrs, s.AdditionalDisks[i].validate(fldPath.Child("additionalDisks").Index(i))...)
+	}
+
+	for i := range s.NodeAffinities {
+		allErrs = append(allErrs, s.NodeAffinities[i].validate(fldPath.Child("nodeAffinities").Index(i))...)
+	}
+
+	if a := s.ReservationAffinity; a != nil {
+		allErrs = append(allErrs, a.validate(fldPath.Child("reservationAffinity"))...)
+	}
+
+	return allErrs
+}
+
+func validateRootDeviceType(t *DiskType, fldPath *field.Path) field.ErrorList {
+	if t == nil {
+		return nil
+	}
+
+	switch *t {
+	case PdStandardDiskType, PdSsdDiskType, PdBalancedDiskType, PdExtremeDiskType, HyperdiskBalancedDiskType:
+		return nil
+	case LocalSsdDiskType:
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("%q disks can't be used as root volumes", LocalSsdDiskType))}
+	default:
+		return field.ErrorList{field.NotSupported(fldPath, *t, []string{
+			string(PdStandardDiskType), string(PdSsdDiskType), string(PdBalancedDiskType), string(PdExtremeDiskType), string(HyperdiskBalancedDiskType),
+		})}
+	}
+}
+
+func (d *AttachedDiskSpec) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	diskType := PdStandardDiskType
+	if d.DeviceType != nil {
+		diskType = *d.DeviceType
+	}
+
+	if d.ProvisionedIOPS != nil && !diskType.SupportsProvisionedIOPS() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedIOPS"), fmt.Sprintf("is not supported for %q disks", diskType)))
+	}
+
+	if d.SourceSnapshot != nil && d.SourceImage != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sourceImage"), "is mutually exclusive with sourceSnapshot"))
+	}
+
+	if diskType != LocalSsdDiskType {
+		if d.Count != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("count"), fmt.Sprintf("is only supported for %q disks", LocalSsdDiskType)))
+		}
+
+		allErrs = append(allErrs, validateDiskSize(diskType, pointer.Int64Deref(d.Size, 30), fldPath.Child("size"))...)
+		allErrs = append(allErrs, d.Labels.validate(fldPath.Child("labels"))...)
+		return allErrs
+	}
+
+	if d.Size != nil && *d.Size != 375 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), *d.Size, fmt.Sprintf("%q disks always have a size of 375GB", LocalSsdDiskType)))
+	}
+
+	if d.AutoDelete != nil && !*d.AutoDelete {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autoDelete"), *d.AutoDelete, fmt.Sprintf("%q disks are always deleted with the instance", LocalSsdDiskType)))
+	}
+
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"name", d.Name != nil},
+		{"sourceSnapshot", d.SourceSnapshot != nil},
+		{"sourceImage", d.SourceImage != nil},
+		{"labels", len(d.Labels) > 0},
+		{"resourcePolicies", len(d.ResourcePolicies) > 0},
+	} {
+		if f.set {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(f.name), fmt.Sprintf("is not supported for %q disks", LocalSsdDiskType)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDiskSize validates the size in GB of a disk of type t.
+func validateDiskSize(t DiskType, size int64, fldPath *field.Path) field.ErrorList {
+	min, max := int64(10), int64(65536)
+	switch t {
+	case PdExtremeDiskType:
+		min = 500
+	case HyperdiskBalancedDiskType:
+		min = 4
+	case HyperdiskExtremeDiskType:
+		min = 64
+	case HyperdiskThroughputDiskType:
+		min, max = 2048, 32768
+	}
+
+	if size < min || size > max {
+		return field.ErrorList{field.Invalid(fldPath, size, fmt.Sprintf("must be between %dGB and %dGB for %q disks", min, max, t))}
+	}
+
+	return nil
+}
+
+// validate validates GCP labels, whose keys must start with a lowercase letter, and whose keys and values
+// may only contain lowercase letters, digits, underscores and dashes.
+func (in Labels) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(in) > maxLabels {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(in), maxLabels))
+	}
+
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(key) > maxLabelLength || !labelKeyRegexp.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("keys must be at most %d characters, start with a lowercase letter and only contain lowercase letters, digits, '_' and '-'", maxLabelLength)))
+		}
+
+		if value := in[key]; len(value) > maxLabelLength || !labelValueRegexp.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, fmt.Sprintf("must be at most %d characters and only contain lowercase letters, digits, '_' and '-'", maxLabelLength)))
+		}
+	}
+
+	return allErrs
+}
+
+func (a *NodeAffinity) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if a.NodeGroup != nil {
+		if a.Key != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("key"), "is mutually exclusive with nodeGroup"))
+		}
+		if len(a.Values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("values"), "is mutually exclusive with nodeGroup"))
+		}
+
+		return allErrs
+	}
+
+	if a.Key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "either key or nodeGroup is required"))
+	}
+	if len(a.Values) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("values"), "is required with key"))
+	}
+
+	return allErrs
+}
+
+func (a *ReservationAffinity) validate(fldPath *field.Path) field.ErrorList {
+	if a.Type == ReservationAffinityTypeSpecific {
+		if len(a.Reservations) == 0 {
+			return field.ErrorList{field.Required(fldPath.Child("reservations"), fmt.Sprintf("is required when type is %q", a.Type))}
+		}
+
+		return nil
+	}
+
+	if len(a.Reservations) > 0 {
+		return field.ErrorList{field.Forbidden(fldPath.Child("reservations"), fmt.Sprintf("is only supported when type is %q", ReservationAffinityTypeSpecific))}
+	}
+
+	return nil
+}
+
+func (n *NetworkInterface) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if n.NetworkIP != nil && net.ParseIP(*n.NetworkIP) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkIP"), *n.NetworkIP, "must be a valid IP address"))
+	}
+
+	if n.IPv6PublicIP != nil && *n.IPv6PublicIP && (n.StackType == nil || *n.StackType != StackTypeIPv4IPv6) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipv6PublicIP"), fmt.Sprintf("requires stackType %q", StackTypeIPv4IPv6)))
+	}
+
+	for i, r := range n.AliasIPRanges {
+		if !isAliasIPCidrRange(r.IPCidrRange) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("aliasIPRanges").Index(i).Child("ipCidrRange"), r.IPCidrRange, "must be a CIDR range or a netmask like \"/24\""))
+		}
+	}
+
+	return allErrs
+}
+
+// isAliasIPCidrRange reports whether r is an IPv4 CIDR range or a netmask like "/24".
+func isAliasIPCidrRange(r string) bool {
+	if strings.HasPrefix(r, "/") {
+		bits, err := strconv.Atoi(r[1:])
+		return err == nil && bits >= 0 && bits <= 32
+	}
+
+	_, _, err := net.ParseCIDR(r)
+	return err == nil
+}
+
+func (s *GCPMachineSpec) validateAddressReservations(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	r := s.AddressReservations
+	if r.Internal != nil {
+		subnet := s.Subnet
+		if len(s.NetworkInterfaces) > 0 {
+			subnet = s.NetworkInterfaces[0].Subnet
+		}
+		if subnet == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("internal"), "requires the subnet of the primary network interface"))
+		}
+		if len(s.NetworkInterfaces) > 0 && s.NetworkInterfaces[0].NetworkIP != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("internal"), "is mutually exclusive with the networkIP of the primary network interface"))
+		}
+	}
+
+	for _, a := range []struct {
+		name        string
+		reservation *AddressReservation
+	}{
+		{"internal", r.Internal},
+		{"external", r.External},
+	} {
+		if a.reservation != nil && a.reservation.Address != nil && net.ParseIP(*a.reservation.Address) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(a.name, "address"), *a.reservation.Address, "must be a valid IP address"))
+		}
+	}
+
+	return allErrs
+}
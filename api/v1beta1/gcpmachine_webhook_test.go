@@ -0,0 +1,668 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestGCPMachine_ValidateCreate(t *testing.T) {
+	pdExtreme := PdExtremeDiskType
+	localSsd := LocalSsdDiskType
+	tests := []struct {
+		name    string
+		spec    GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "valid machine (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:       "n1-standard-2",
+				AdditionalLabels:   Labels{"team": "platform"},
+				AdditionalMetadata: []MetadataItem{{Key: "enable-oslogin", Value: pointer.String("TRUE")}},
+			},
+		},
+		{
+			name:    "invalid label key (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalLabels: Labels{"team.io/name": "platform"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label value (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalLabels: Labels{"team": "Platform"}},
+			wantErr: true,
+		},
+		{
+			name:    "reserved metadata key (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalMetadata: []MetadataItem{{Key: "user-data"}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate metadata key (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalMetadata: []MetadataItem{{Key: "enable-oslogin"}, {Key: "enable-oslogin"}}},
+			wantErr: true,
+		},
+		{
+			name: "root disk within the size limits of its type (should succeed)",
+			spec: GCPMachineSpec{InstanceType: "n1-standard-2", RootDeviceType: &pdExtreme, RootDeviceSize: 500},
+		},
+		{
+			name:    "root disk below the minimum size of its type (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", RootDeviceType: &pdExtreme, RootDeviceSize: 100},
+			wantErr: true,
+		},
+		{
+			name:    "root disk above the maximum size (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", RootDeviceSize: 65537},
+			wantErr: true,
+		},
+		{
+			name:    "local SSD root disk (should fail)",
+			spec:    GCPMachineSpec{InstanceType: "n1-standard-2", RootDeviceType: &localSsd},
+			wantErr: true,
+		},
+		{
+			name: "additional disk below the minimum size (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:    "n1-standard-2",
+				AdditionalDisks: []AttachedDiskSpec{{Size: pointer.Int64(5)}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.spec}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     GCPMachineSpec
+		new     GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "placing a machine in a failure domain (should succeed)",
+			old:  GCPMachineSpec{InstanceType: "n1-standard-2"},
+			new:  GCPMachineSpec{InstanceType: "n1-standard-2", FailureDomain: pointer.String("us-central1-a")},
+		},
+		{
+			name: "changing the failure domain before the instance is created (should succeed)",
+			old:  GCPMachineSpec{InstanceType: "n1-standard-2", FailureDomain: pointer.String("us-central1-a")},
+			new:  GCPMachineSpec{InstanceType: "n1-standard-2", FailureDomain: pointer.String("us-central1-b")},
+		},
+		{
+			name: "setting the failure domain and the provider ID of a new instance (should succeed)",
+			old:  GCPMachineSpec{InstanceType: "n1-standard-2", FailureDomain: pointer.String("us-central1-a")},
+			new: GCPMachineSpec{
+				InstanceType:  "n1-standard-2",
+				FailureDomain: pointer.String("us-central1-b"),
+				ProviderID:    pointer.String("gce://my-proj/us-central1-b/my-machine"),
+			},
+		},
+		{
+			name: "changing the failure domain of an existing instance (should fail)",
+			old: GCPMachineSpec{
+				InstanceType:  "n1-standard-2",
+				FailureDomain: pointer.String("us-central1-a"),
+				ProviderID:    pointer.String("gce://my-proj/us-central1-a/my-machine"),
+			},
+			new: GCPMachineSpec{
+				InstanceType:  "n1-standard-2",
+				FailureDomain: pointer.String("us-central1-b"),
+				ProviderID:    pointer.String("gce://my-proj/us-central1-a/my-machine"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "removing the failure domain of an existing instance (should fail)",
+			old: GCPMachineSpec{
+				InstanceType:  "n1-standard-2",
+				FailureDomain: pointer.String("us-central1-a"),
+				ProviderID:    pointer.String("gce://my-proj/us-central1-a/my-machine"),
+			},
+			new: GCPMachineSpec{
+				InstanceType: "n1-standard-2",
+				ProviderID:   pointer.String("gce://my-proj/us-central1-a/my-machine"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "updating a machine accepted before its labels were validated (should succeed)",
+			old:  GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalLabels: Labels{"Team": "platform"}},
+			new: GCPMachineSpec{
+				InstanceType:     "n1-standard-2",
+				AdditionalLabels: Labels{"Team": "platform"},
+				ProviderID:       pointer.String("gce://my-proj/us-central1-a/my-machine"),
+			},
+		},
+		{
+			name:    "adding an invalid label (should fail)",
+			old:     GCPMachineSpec{InstanceType: "n1-standard-2"},
+			new:     GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalLabels: Labels{"Team": "platform"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.old}
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.new}
+			m.Default()
+			if err := m.ValidateUpdate(old); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateCreate_CustomMachineType(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "n1 custom machine type with one vCPU (should succeed)",
+			spec: GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 1, MemoryMB: 3840}},
+		},
+		{
+			name:    "n1 custom machine type with an odd number of vCPUs (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 3, MemoryMB: 3072}},
+			wantErr: true,
+		},
+		{
+			name:    "n1 custom machine type with too many vCPUs (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 98, MemoryMB: 100352}},
+			wantErr: true,
+		},
+		{
+			name:    "n1 custom machine type with less memory per vCPU than the minimum (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 4, MemoryMB: 3584}},
+			wantErr: true,
+		},
+		{
+			name:    "n1 custom machine type with more memory per vCPU than the maximum (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 2, MemoryMB: 14336}},
+			wantErr: true,
+		},
+		{
+			name: "n1 custom machine type with extended memory (should succeed)",
+			spec: GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 2, MemoryMB: 14336, ExtendedMemory: true}},
+		},
+		{
+			name:    "custom machine type with memory not a multiple of 256MB (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{CPUs: 2, MemoryMB: 4000}},
+			wantErr: true,
+		},
+		{
+			name: "n2 custom machine type with a multiple of 4 vCPUs above 32 (should succeed)",
+			spec: GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesN2, CPUs: 36, MemoryMB: 36864}},
+		},
+		{
+			name:    "n2 custom machine type with a vCPU count above 32 not a multiple of 4 (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesN2, CPUs: 34, MemoryMB: 34816}},
+			wantErr: true,
+		},
+		{
+			name:    "n2 custom machine type with less memory per vCPU than the minimum (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesN2, CPUs: 2, MemoryMB: 768}},
+			wantErr: true,
+		},
+		{
+			name: "n2d custom machine type with a multiple of 16 vCPUs (should succeed)",
+			spec: GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesN2D, CPUs: 16, MemoryMB: 16384}},
+		},
+		{
+			name:    "n2d custom machine type with an unsupported vCPU count (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesN2D, CPUs: 6, MemoryMB: 6144}},
+			wantErr: true,
+		},
+		{
+			name: "e2 custom machine type (should succeed)",
+			spec: GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesE2, CPUs: 2, MemoryMB: 4096}},
+		},
+		{
+			name:    "e2 custom machine type with one vCPU (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesE2, CPUs: 1, MemoryMB: 1024}},
+			wantErr: true,
+		},
+		{
+			name:    "e2 custom machine type with extended memory (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesE2, CPUs: 2, MemoryMB: 4096, ExtendedMemory: true}},
+			wantErr: true,
+		},
+		{
+			name:    "e2 custom machine type with more memory than the maximum (should fail)",
+			spec:    GCPMachineSpec{CustomMachineType: &CustomMachineType{Series: CustomMachineTypeSeriesE2, CPUs: 32, MemoryMB: 139264}},
+			wantErr: true,
+		},
+		{
+			name: "custom machine type and instance type (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				CustomMachineType: &CustomMachineType{CPUs: 2, MemoryMB: 7680},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "neither custom machine type nor instance type (should fail)",
+			spec:    GCPMachineSpec{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.spec}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateCreate_AdditionalDisks(t *testing.T) {
+	pdSsd := PdSsdDiskType
+	pdExtreme := PdExtremeDiskType
+	hyperdiskBalanced := HyperdiskBalancedDiskType
+	hyperdiskExtreme := HyperdiskExtremeDiskType
+	hyperdiskThroughput := HyperdiskThroughputDiskType
+	localSsd := LocalSsdDiskType
+	tests := []struct {
+		name    string
+		disk    AttachedDiskSpec
+		wantErr bool
+	}{
+		{
+			name: "pd-extreme disk with provisioned IOPS (should succeed)",
+			disk: AttachedDiskSpec{DeviceType: &pdExtreme, Size: pointer.Int64(500), ProvisionedIOPS: pointer.Int64(10000)},
+		},
+		{
+			name: "hyperdisk-balanced disk with provisioned IOPS (should succeed)",
+			disk: AttachedDiskSpec{DeviceType: &hyperdiskBalanced, Size: pointer.Int64(100), ProvisionedIOPS: pointer.Int64(3000)},
+		},
+		{
+			name: "hyperdisk-extreme disk with provisioned IOPS (should succeed)",
+			disk: AttachedDiskSpec{DeviceType: &hyperdiskExtreme, Size: pointer.Int64(100), ProvisionedIOPS: pointer.Int64(3000)},
+		},
+		{
+			name:    "pd-ssd disk with provisioned IOPS (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &pdSsd, ProvisionedIOPS: pointer.Int64(3000)},
+			wantErr: true,
+		},
+		{
+			name:    "hyperdisk-throughput disk with provisioned IOPS (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &hyperdiskThroughput, Size: pointer.Int64(2048), ProvisionedIOPS: pointer.Int64(3000)},
+			wantErr: true,
+		},
+		{
+			name:    "pd-standard disk with provisioned IOPS (should fail)",
+			disk:    AttachedDiskSpec{ProvisionedIOPS: pointer.Int64(3000)},
+			wantErr: true,
+		},
+		{
+			name:    "disk with a source snapshot and a source image (should fail)",
+			disk:    AttachedDiskSpec{SourceSnapshot: pointer.String("global/snapshots/my-snapshot"), SourceImage: pointer.String("global/images/my-image")},
+			wantErr: true,
+		},
+		{
+			name: "several local SSDs (should succeed)",
+			disk: AttachedDiskSpec{DeviceType: &localSsd, Count: pointer.Int32(4)},
+		},
+		{
+			name:    "count of persistent disks (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &pdSsd, Count: pointer.Int32(2)},
+			wantErr: true,
+		},
+		{
+			name:    "local SSD with another size than 375GB (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &localSsd, Size: pointer.Int64(100)},
+			wantErr: true,
+		},
+		{
+			name:    "local SSD not deleted with the instance (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &localSsd, AutoDelete: pointer.Bool(false)},
+			wantErr: true,
+		},
+		{
+			name:    "local SSD with a name (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &localSsd, Name: pointer.String("scratch")},
+			wantErr: true,
+		},
+		{
+			name:    "local SSD with labels (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &localSsd, Labels: Labels{"team": "platform"}},
+			wantErr: true,
+		},
+		{
+			name:    "hyperdisk-throughput disk below the minimum size (should fail)",
+			disk:    AttachedDiskSpec{DeviceType: &hyperdiskThroughput, Size: pointer.Int64(1024)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-machine"},
+				Spec:       GCPMachineSpec{InstanceType: "n1-standard-2", AdditionalDisks: []AttachedDiskSpec{tt.disk}},
+			}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateCreate_Affinities(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "node affinity with a key and values (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{Key: "license", Operator: NodeAffinityOperatorIn, Values: []string{"per-host"}}},
+			},
+		},
+		{
+			name: "node affinity with a node group (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{NodeGroup: pointer.String("my-node-group")}},
+			},
+		},
+		{
+			name: "node affinity with a node group and a key (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{NodeGroup: pointer.String("my-node-group"), Key: "license"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node affinity with a node group and values (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{NodeGroup: pointer.String("my-node-group"), Values: []string{"per-host"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node affinity with neither a key nor a node group (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{Values: []string{"per-host"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node affinity with a key and no values (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:   "n1-standard-2",
+				NodeAffinities: []NodeAffinity{{Key: "license"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "specific reservation affinity with reservations (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				ReservationAffinity: &ReservationAffinity{Type: ReservationAffinityTypeSpecific, Reservations: []string{"my-reservation"}},
+			},
+		},
+		{
+			name: "specific reservation affinity without reservations (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				ReservationAffinity: &ReservationAffinity{Type: ReservationAffinityTypeSpecific},
+			},
+			wantErr: true,
+		},
+		{
+			name: "any reservation affinity (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				ReservationAffinity: &ReservationAffinity{Type: ReservationAffinityTypeAny},
+			},
+		},
+		{
+			name: "any reservation affinity with reservations (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				ReservationAffinity: &ReservationAffinity{Type: ReservationAffinityTypeAny, Reservations: []string{"my-reservation"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no reservation affinity with reservations (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				ReservationAffinity: &ReservationAffinity{Type: ReservationAffinityTypeNone, Reservations: []string{"my-reservation"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.spec}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateCreate_NetworkInterfaces(t *testing.T) {
+	dualStack := StackTypeIPv4IPv6
+	tests := []struct {
+		name    string
+		spec    GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "network interfaces with alias IP ranges (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType: "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{
+					{
+						Subnet:    pointer.String("my-subnet"),
+						NetworkIP: pointer.String("10.0.0.10"),
+						AliasIPRanges: []AliasIPRange{
+							{IPCidrRange: "/24", SubnetworkRangeName: pointer.String("pods")},
+							{IPCidrRange: "10.2.3.0/24"},
+						},
+					},
+					{Network: pointer.String("storage")},
+				},
+			},
+		},
+		{
+			name: "alias IP range with an invalid CIDR (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{AliasIPRanges: []AliasIPRange{{IPCidrRange: "10.2.3.0/33"}}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "alias IP range with an invalid netmask (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{AliasIPRanges: []AliasIPRange{{IPCidrRange: "/33"}}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "alias IP range with a subnetwork range name but no range (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{AliasIPRanges: []AliasIPRange{{SubnetworkRangeName: pointer.String("pods")}}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interface with an invalid network IP (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{NetworkIP: pointer.String("10.0.0.256")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interfaces connected to the same network (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{Network: pointer.String("storage")}, {Network: pointer.String("storage")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interfaces and subnet (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				Subnet:            pointer.String("my-subnet"),
+				NetworkInterfaces: []NetworkInterface{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "network interfaces and public IP (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				PublicIP:          pointer.Bool(true),
+				NetworkInterfaces: []NetworkInterface{{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dual-stack network interface with a public IPv6 address (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{StackType: &dualStack, IPv6PublicIP: pointer.Bool(true)}},
+			},
+		},
+		{
+			name: "IPv4 network interface with a public IPv6 address (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				NetworkInterfaces: []NetworkInterface{{IPv6PublicIP: pointer.Bool(true)}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.spec}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGCPMachine_ValidateCreate_AddressReservations(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    GCPMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "internal and external address reservations (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType: "n1-standard-2",
+				Subnet:       pointer.String("my-subnet"),
+				AddressReservations: &AddressReservations{
+					Internal: &AddressReservation{Address: pointer.String("10.0.0.10"), Preserve: true},
+					External: &AddressReservation{},
+				},
+			},
+		},
+		{
+			name: "internal address reservation in the subnet of the primary network interface (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				NetworkInterfaces:   []NetworkInterface{{Subnet: pointer.String("my-subnet")}},
+				AddressReservations: &AddressReservations{Internal: &AddressReservation{}},
+			},
+		},
+		{
+			name: "external address reservation without a subnet (should succeed)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				AddressReservations: &AddressReservations{External: &AddressReservation{Address: pointer.String("203.0.113.10")}},
+			},
+		},
+		{
+			name: "internal address reservation without a subnet (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				AddressReservations: &AddressReservations{Internal: &AddressReservation{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "internal address reservation and network IP of the primary network interface (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				NetworkInterfaces:   []NetworkInterface{{Subnet: pointer.String("my-subnet"), NetworkIP: pointer.String("10.0.0.10")}},
+				AddressReservations: &AddressReservations{Internal: &AddressReservation{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "internal address reservation with an invalid address (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				Subnet:              pointer.String("my-subnet"),
+				AddressReservations: &AddressReservations{Internal: &AddressReservation{Address: pointer.String("10.0.0")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "external address reservation with an invalid address (should fail)",
+			spec: GCPMachineSpec{
+				InstanceType:        "n1-standard-2",
+				AddressReservations: &AddressReservations{External: &AddressReservation{Address: pointer.String("my-address")}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &GCPMachine{ObjectMeta: metav1.ObjectMeta{Name: "my-machine"}, Spec: tt.spec}
+			m.Default()
+			if err := m.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("GCPMachine.ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// machinetemplatelog is for logging in this package.
+var machinetemplatelog = logf.Log.WithName("gcpmachinetemplate-resource")
+
+func (r *GCPMachineTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachinetemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinetemplates,versions=v1beta1,name=validation.gcpmachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-infrastructure-cluster-x-k8s-io-v1beta1-gcpmachinetemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinetemplates,versions=v1beta1,name=default.gcpmachinetemplate.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &GCPMachineTemplate{}
+var _ webhook.Defaulter = &GCPMachineTemplate{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+// Templates get the same defaults as GCPMachines, so that the machines created from a
+// template don't drift from it once defaulted.
+func (r *GCPMachineTemplate) Default() {
+	machinetemplatelog.Info("default", "name", r.Name)
+
+	r.Spec.Template.Spec.setDefaults()
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *GCPMachineTemplate) ValidateCreate() error {
+	machinetemplatelog.Info("validate create", "name", r.Name)
+
+	allErrs := r.Spec.Template.Spec.validate(field.NewPath("spec", "template", "spec"))
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachineTemplate").GroupKind(), r.Name, allErrs)
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+// Templates are immutable, so that the machines created from a template all share the same spec.
+func (r *GCPMachineTemplate) ValidateUpdate(oldRaw runtime.Object) error {
+	machinetemplatelog.Info("validate update", "name", r.Name)
+	// GCPMachineTemplates stored before their defaults were materialized get defaulted on update.
+	old := oldRaw.DeepCopyObject().(*GCPMachineTemplate)
+	old.Default()
+
+	if !reflect.DeepEqual(r.Spec, old.Spec) {
+		return apierrors.NewInvalid(GroupVersion.WithKind("GCPMachineTemplate").GroupKind(), r.Name, field.ErrorList{
+			field.Forbidden(field.NewPath("spec"), "GCPMachineTemplate spec is immutable"),
+		})
+	}
+
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *GCPMachineTemplate) ValidateDelete() error {
+	machinetemplatelog.Info("validate delete", "name", r.Name)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// DefaultImageFamilyTemplate is the image family template used when a GCPMachine doesn't specify one.
+	DefaultImageFamilyTemplate = "projects/{{.Project}}/global/images/family/capi-ubuntu-1804-k8s-{{.KubernetesMinorVersion}}"
+
+	// DefaultImageLookupFamilyTemplate is the family template used by image lookups not specifying
+	// any family template, name filter or labels.
+	DefaultImageLookupFamilyTemplate = "capi-{{.BaseOS}}-k8s-{{.KubernetesMinorVersion}}"
+
+	// DefaultImageLookupNameFilterTemplate is the name filter template used by image lookups not specifying one,
+	// matching the images built by image-builder for the Kubernetes version of the machine.
+	DefaultImageLookupNameFilterTemplate = ".*-{{.KubernetesVersion}}(-.*)?"
+
+	// DefaultImageLookupBaseOS is the OS flavor used by image lookups not specifying one.
+	DefaultImageLookupBaseOS = "ubuntu-1804"
+)
+
+// imageTemplateData is the data available to image templates.
+type imageTemplateData struct {
+	// Project is the project of the cluster.
+	Project string
+	// BaseOS is the OS flavor of the image lookup, e.g. "ubuntu-2004" or "flatcar-stable".
+	BaseOS string
+
+	version string
+}
+
+// KubernetesVersion returns the Kubernetes version with dashes, e.g. "v1-21-2".
+func (d imageTemplateData) KubernetesVersion() (string, error) {
+	if !semver.IsValid(d.version) {
+		return "", d.versionError()
+	}
+
+	return strings.ReplaceAll(d.version, ".", "-"), nil
+}
+
+// KubernetesMinorVersion returns the Kubernetes minor version with dashes, e.g. "v1-21".
+func (d imageTemplateData) KubernetesMinorVersion() (string, error) {
+	if !semver.IsValid(d.version) {
+		return "", d.versionError()
+	}
+
+	return strings.ReplaceAll(semver.MajorMinor(d.version), ".", "-"), nil
+}
+
+func (d imageTemplateData) versionError() error {
+	if d.version == "" {
+		return errors.New("the Machine has no Kubernetes version")
+	}
+
+	return errors.Errorf("%q isn't a valid Kubernetes version", d.version)
+}
+
+// RenderImageTemplate renders an image template for the given project, OS flavor and Kubernetes version.
+// It returns an error if the template uses the Kubernetes version while version is empty or invalid.
+func RenderImageTemplate(tmpl, project, baseOS, version string) (string, error) {
+	t, err := template.New("image").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse template")
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, imageTemplateData{
+		Project: project,
+		BaseOS:  baseOS,
+		version: version,
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to execute template")
+	}
+
+	return buf.String(), nil
+}
+
+func (l *ImageLookup) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if t := l.FamilyTemplate; t != nil {
+		if _, err := RenderImageTemplate(*t, "project", DefaultImageLookupBaseOS, "v1.21.0"); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("familyTemplate"), *t, err.Error()))
+		}
+	}
+
+	if t := l.NameFilter; t != nil {
+		filter, err := RenderImageTemplate(*t, "project", DefaultImageLookupBaseOS, "v1.21.0")
+		if err == nil {
+			_, err = regexp.Compile(filter)
+		}
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nameFilter"), *t, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"path"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// customMachineTypeShape describes the constraints of the custom machine types of a series.
+type customMachineTypeShape struct {
+	// maxCPUs is the maximum number of vCPUs.
+	maxCPUs int64
+	// validCPUs reports whether the number of vCPUs is supported.
+	validCPUs func(cpus int64) bool
+	// cpusHint describes the supported numbers of vCPUs.
+	cpusHint string
+	// minMemoryPerCPU and maxMemoryPerCPU bound the memory in MB per vCPU, unless extended.
+	minMemoryPerCPU, maxMemoryPerCPU float64
+	// maxMemory is the maximum amount of memory in MB, or 0 when only bound per vCPU.
+	maxMemory int64
+	// extendedMemory reports whether the series supports extended memory.
+	extendedMemory bool
+}
+
+var customMachineTypeShapes = map[CustomMachineTypeSeries]customMachineTypeShape{
+	CustomMachineTypeSeriesN1: {
+		maxCPUs:         96,
+		validCPUs:       func(cpus int64) bool { return cpus == 1 || cpus%2 == 0 },
+		cpusHint:        "must be 1 or an even number",
+		minMemoryPerCPU: 0.9 * 1024,
+		maxMemoryPerCPU: 6.5 * 1024,
+		extendedMemory:  true,
+	},
+	CustomMachineTypeSeriesN2: {
+		maxCPUs: 80,
+		validCPUs: func(cpus int64) bool {
+			if cpus <= 32 {
+				return cpus%2 == 0
+			}
+			return cpus%4 == 0
+		},
+		cpusHint:        "must be a multiple of 2 up to 32, and a multiple of 4 above",
+		minMemoryPerCPU: 0.5 * 1024,
+		maxMemoryPerCPU: 8 * 1024,
+		extendedMemory:  true,
+	},
+	CustomMachineTypeSeriesN2D: {
+		maxCPUs: 96,
+		validCPUs: func(cpus int64) bool {
+			if cpus < 16 {
+				return cpus == 2 || cpus == 4 || cpus == 8
+			}
+			return cpus%16 == 0
+		},
+		cpusHint:        "must be 2, 4, 8 or a multiple of 16",
+		minMemoryPerCPU: 0.5 * 1024,
+		maxMemoryPerCPU: 8 * 1024,
+		extendedMemory:  true,
+	},
+	CustomMachineTypeSeriesE2: {
+		maxCPUs:         32,
+		validCPUs:       func(cpus int64) bool { return cpus >= 2 && cpus%2 == 0 },
+		cpusHint:        "must be an even number",
+		minMemoryPerCPU: 0.5 * 1024,
+		maxMemoryPerCPU: 8 * 1024,
+		maxMemory:       128 * 1024,
+	},
+}
+
+// series returns the machine series of the custom machine type, defaulting to N1.
+func (c *CustomMachineType) series() CustomMachineTypeSeries {
+	if c.Series == "" {
+		return CustomMachineTypeSeriesN1
+	}
+
+	return c.Series
+}
+
+// Name returns the name of the custom machine type, e.g. "n2-custom-4-8192" or "custom-2-15360-ext".
+func (c *CustomMachineType) Name() string {
+	name := fmt.Sprintf("custom-%d-%d", c.CPUs, c.MemoryMB)
+	if series := c.series(); series != CustomMachineTypeSeriesN1 {
+		name = fmt.Sprintf("%s-%s", series, name)
+	}
+
+	if c.ExtendedMemory {
+		name += "-ext"
+	}
+
+	return name
+}
+
+// MachineType returns the name of the machine type of the instance.
+func (s *GCPMachineSpec) MachineType() string {
+	if s.CustomMachineType != nil {
+		return s.CustomMachineType.Name()
+	}
+
+	return s.InstanceType
+}
+
+// MachineTypeURL returns the partial URL of a machine type in a zone.
+func MachineTypeURL(zone, machineType string) string {
+	return path.Join("zones", zone, "machineTypes", machineType)
+}
+
+// AcceleratorTypeURL returns the partial URL of an accelerator type in a zone.
+func AcceleratorTypeURL(zone, acceleratorType string) string {
+	return path.Join("zones", zone, "acceleratorTypes", acceleratorType)
+}
+
+func (c *CustomMachineType) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	shape, ok := customMachineTypeShapes[c.series()]
+	if !ok {
+		return field.ErrorList{field.NotSupported(fldPath.Child("series"), c.Series, []string{
+			string(CustomMachineTypeSeriesN1), string(CustomMachineTypeSeriesN2), string(CustomMachineTypeSeriesN2D), string(CustomMachineTypeSeriesE2),
+		})}
+	}
+
+	if c.CPUs < 1 || c.CPUs > shape.maxCPUs {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpus"), c.CPUs, fmt.Sprintf("must be between 1 and %d for the %q series", shape.maxCPUs, c.series())))
+	} else if !shape.validCPUs(c.CPUs) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpus"), c.CPUs, fmt.Sprintf("%s for the %q series", shape.cpusHint, c.series())))
+	}
+
+	if c.MemoryMB%256 != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMB"), c.MemoryMB, "must be a multiple of 256"))
+	}
+
+	if c.ExtendedMemory && !shape.extendedMemory {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("extendedMemory"), fmt.Sprintf("is not supported for the %q series", c.series())))
+	}
+
+	if c.CPUs < 1 {
+		return allErrs
+	}
+
+	perCPU := float64(c.MemoryMB) / float64(c.CPUs)
+	if perCPU < shape.minMemoryPerCPU {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMB"), c.MemoryMB, fmt.Sprintf("must be at least %.0fMB per vCPU for the %q series", shape.minMemoryPerCPU, c.series())))
+	}
+	if !c.ExtendedMemory && perCPU > shape.maxMemoryPerCPU {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMB"), c.MemoryMB, fmt.Sprintf("must be at most %.0fMB per vCPU for the %q series without extended memory", shape.maxMemoryPerCPU, c.series())))
+	}
+	if shape.maxMemory > 0 && c.MemoryMB > shape.maxMemory {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMB"), c.MemoryMB, fmt.Sprintf("must be at most %dMB for the %q series", shape.maxMemory, c.series())))
+	}
+
+	return allErrs
+}
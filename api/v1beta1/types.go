@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+)
+
+// GCPMachineTemplateResource describes the data needed to create am GCPMachine from a template.
+type GCPMachineTemplateResource struct {
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	ObjectMeta clusterv1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the desired behavior of the machine.
+	Spec GCPMachineSpec `json:"spec"`
+}
+
+// Filter is a filter used to identify an GCP resource.
+type Filter struct {
+	// Name of the filter. Filter names are case-sensitive.
+	Name string `json:"name"`
+
+	// Values includes one or more filter values. Filter values are case-sensitive.
+	Values []string `json:"values"`
+}
+
+// Network encapsulates GCP networking resources.
+type Network struct {
+	// SelfLink is the link to the Network used for this cluster.
+	SelfLink *string `json:"selfLink,omitempty"`
+
+	// FirewallRules is a map from the name of the rule to its full reference.
+	// +optional
+	FirewallRules map[string]string `json:"firewallRules,omitempty"`
+
+	// Router is the full reference to the router created within the network
+	// it'll contain the cloud nat gateway
+	// +optional
+	Router *string `json:"router,omitempty"`
+
+	// APIServerAddress is the IPV4 global address assigned to the load balancer
+	// created for the API Server.
+	// +optional
+	APIServerAddress *string `json:"apiServerIpAddress,omitempty"`
+
+	// APIServerIP is the IP address of the load balancer created for the API Server.
+	// +optional
+	APIServerIP *string `json:"apiServerIP,omitempty"`
+
+	// APIServerHealthCheck is the full reference to the health check
+	// created for the API Server.
+	// +optional
+	APIServerHealthCheck *string `json:"apiServerHealthCheck,omitempty"`
+
+	// APIServerInstanceGroups is a map from zone to the full reference
+	// to the instance groups created for the control plane nodes created in the same zone.
+	// +optional
+	APIServerInstanceGroups map[string]string `json:"apiServerInstanceGroups,omitempty"`
+
+	// APIServerBackendService is the full reference to the backend service
+	// created for the API Server.
+	// +optional
+	APIServerBackendService *string `json:"apiServerBackendService,omitempty"`
+
+	// APIServerTargetProxy is the full reference to the target proxy
+	// created for the API Server.
+	// +optional
+	APIServerTargetProxy *string `json:"apiServerTargetProxy,omitempty"`
+
+	// APIServerForwardingRule is the full reference to the forwarding rule
+	// created for the API Server.
+	// +optional
+	APIServerForwardingRule *string `json:"apiServerForwardingRule,omitempty"`
+
+	// APIServerIPv6Address is the IPV6 global address assigned to the load balancer
+	// created for the API Server, when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6Address *string `json:"apiServerIPv6Address,omitempty"`
+
+	// APIServerIPv6 is the IPv6 address of the load balancer created for the API Server,
+	// when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6 *string `json:"apiServerIPv6,omitempty"`
+
+	// APIServerIPv6ForwardingRule is the full reference to the IPV6 forwarding rule
+	// created for the API Server, when its frontend is dual-stack.
+	// +optional
+	APIServerIPv6ForwardingRule *string `json:"apiServerIPv6ForwardingRule,omitempty"`
+}
+
+// StackType is the IP stack of a subnetwork, network interface or load balancer frontend.
+// +kubebuilder:validation:Enum=IPV4_ONLY;IPV4_IPV6
+type StackType string
+
+const (
+	// StackTypeIPv4Only is the IPv4 single stack.
+	StackTypeIPv4Only = StackType("IPV4_ONLY")
+	// StackTypeIPv4IPv6 is the IPv4 and IPv6 dual stack.
+	StackTypeIPv4IPv6 = StackType("IPV4_IPV6")
+)
+
+// IPv6AccessType is the access type of the IPv6 addresses of a subnetwork.
+// +kubebuilder:validation:Enum=INTERNAL;EXTERNAL
+type IPv6AccessType string
+
+const (
+	// IPv6AccessTypeInternal allocates IPv6 addresses only reachable within the VPC network.
+	IPv6AccessTypeInternal = IPv6AccessType("INTERNAL")
+	// IPv6AccessTypeExternal allocates IPv6 addresses reachable from the internet.
+	IPv6AccessTypeExternal = IPv6AccessType("EXTERNAL")
+)
+
+// NetworkTier is the network tier of external IP addresses.
+// +kubebuilder:validation:Enum=Premium;Standard
+type NetworkTier string
+
+const (
+	// NetworkTierPremium is the Premium network tier, using Google's global network.
+	NetworkTierPremium = NetworkTier("Premium")
+	// NetworkTierStandard is the Standard network tier, using regional peering with the internet.
+	NetworkTierStandard = NetworkTier("Standard")
+)
+
+// NetworkSpec encapsulates all things related to a GCP network.
+type NetworkSpec struct {
+	// Name is the name of the network to be used. Defaults to "default".
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// AutoCreateSubnetworks: When set to true, the VPC network is created
+	// in "auto" mode. When set to false, the VPC network is created in
+	// "custom" mode.
+	//
+	// An auto mode VPC network starts with one subnet per region. Each
+	// subnet has a predetermined range as described in Auto mode VPC
+	// network IP ranges.
+	//
+	// Defaults to true.
+	// +optional
+	AutoCreateSubnetworks *bool `json:"autoCreateSubnetworks,omitempty"`
+
+	// Subnets configuration.
+	// +optional
+	Subnets Subnets `json:"subnets,omitempty"`
+
+	// Allow for configuration of load balancer backend (useful for changing apiserver port)
+	// +optional
+	LoadBalancerBackendPort *int32 `json:"loadBalancerBackendPort,omitempty"`
+
+	// APIServerStackType is the IP stack of the API server load balancer frontend. With IPV4_IPV6
+	// an additional IPv6 address and forwarding rule are created, which requires the Premium network tier.
+	// Defaults to IPV4_ONLY.
+	// +optional
+	APIServerStackType *StackType `json:"apiServerStackType,omitempty"`
+}
+
+// SubnetSpec configures an GCP Subnet.
+type SubnetSpec struct {
+	// Name defines a unique identifier to reference this resource.
+	Name string `json:"name,omitempty"`
+
+	// CidrBlock is the range of internal addresses that are owned by this
+	// subnetwork. Provide this property when you create the subnetwork. For
+	// example, 10.0.0.0/8 or 192.168.0.0/16. Ranges must be unique and
+	// non-overlapping within a network. This field can be set only at
+	// resource creation time.
+	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// Description is an optional description associated with the resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SecondaryCidrBlocks defines secondary CIDR ranges,
+	// from which secondary IP ranges of a VM may be allocated
+	// +optional
+	SecondaryCidrBlocks map[string]string `json:"secondaryCidrBlocks,omitempty"`
+
+	// Region is the name of the region where the Subnetwork resides.
+	Region string `json:"region,omitempty"`
+
+	// PrivateGoogleAccess defines whether VMs in this subnet can access
+	// Google services without assigning external IP addresses
+	// +optional
+	PrivateGoogleAccess *bool `json:"privateGoogleAccess,omitempty"`
+
+	// EnableFlowLogs: Whether to enable flow logging for this subnetwork.
+	// If this field is not explicitly set, it will not appear in get
+	// listings. If not set the default behavior is to disable flow logging.
+	// +optional
+	EnableFlowLogs *bool `json:"routeTableId"`
+
+	// StackType is the IP stack of the subnetwork. IPv6 ranges are allocated by GCP.
+	// IPV4_IPV6 requires a custom mode network, with AutoCreateSubnetworks set to false.
+	// Defaults to IPV4_ONLY.
+	// +optional
+	StackType *StackType `json:"stackType,omitempty"`
+
+	// IPv6AccessType is the access type of the IPv6 addresses of a dual-stack subnetwork.
+	// Required when StackType is IPV4_IPV6.
+	// +optional
+	IPv6AccessType *IPv6AccessType `json:"ipv6AccessType,omitempty"`
+}
+
+// String returns a string representation of the subnet.
+func (s *SubnetSpec) String() string {
+	return fmt.Sprintf("name=%s/region=%s", s.Name, s.Region)
+}
+
+// Subnets is a slice of Subnet.
+type Subnets []*SubnetSpec
+
+// ToMap returns a map from name to subnet.
+func (s Subnets) ToMap() map[string]*SubnetSpec {
+	res := make(map[string]*SubnetSpec)
+	for _, x := range s {
+		res[x.Name] = x
+	}
+
+	return res
+}
+
+// FindByName returns a single subnet matching the given name or nil.
+func (s Subnets) FindByName(name string) *SubnetSpec {
+	for _, x := range s {
+		if x.Name == name {
+			return x
+		}
+	}
+
+	return nil
+}
+
+// FilterByRegion returns a slice containing all subnets that live in the specified region.
+func (s Subnets) FilterByRegion(region string) (res Subnets) {
+	for _, x := range s {
+		if x.Region == region {
+			res = append(res, x)
+		}
+	}
+
+	return
+}
+
+// InstanceStatus describes the state of an GCP instance.
+type InstanceStatus string
+
+var (
+	// InstanceStatusProvisioning is the string representing an instance in a provisioning state.
+	InstanceStatusProvisioning = InstanceStatus("PROVISIONING")
+
+	// InstanceStatusRepairing is the string representing an instance in a repairing state.
+	InstanceStatusRepairing = InstanceStatus("REPAIRING")
+
+	// InstanceStatusRunning is the string representing an instance in a pending state.
+	InstanceStatusRunning = InstanceStatus("RUNNING")
+
+	// InstanceStatusStaging is the string representing an instance in a staging state.
+	InstanceStatusStaging = InstanceStatus("STAGING")
+
+	// InstanceStatusStopped is the string representing an instance
+	// that has been stopped and can be restarted.
+	InstanceStatusStopped = InstanceStatus("STOPPED")
+
+	// InstanceStatusStopping is the string representing an instance
+	// that is in the process of being stopped and can be restarted.
+	InstanceStatusStopping = InstanceStatus("STOPPING")
+
+	// InstanceStatusSuspended is the string representing an instance
+	// that is suspended.
+	InstanceStatusSuspended = InstanceStatus("SUSPENDED")
+
+	// InstanceStatusSuspending is the string representing an instance
+	// that is in the process of being suspended.
+	InstanceStatusSuspending = InstanceStatus("SUSPENDING")
+
+	// InstanceStatusTerminated is the string representing an instance that has been terminated.
+	InstanceStatusTerminated = InstanceStatus("TERMINATED")
+)
+
+// ServiceAccount describes compute.serviceAccount.
+type ServiceAccount struct {
+	// Email: Email address of the service account.
+	Email string `json:"email,omitempty"`
+
+	// Scopes: The list of scopes to be made available for this service
+	// account.
+	Scopes []string `json:"scopes,omitempty"`
+}
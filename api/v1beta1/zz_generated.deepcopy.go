@@ -0,0 +1,1486 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/cluster-api/errors"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressReservation) DeepCopyInto(out *AddressReservation) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressReservation.
+func (in *AddressReservation) DeepCopy() *AddressReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddressReservations) DeepCopyInto(out *AddressReservations) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(AddressReservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(AddressReservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressReservations.
+func (in *AddressReservations) DeepCopy() *AddressReservations {
+	if in == nil {
+		return nil
+	}
+	out := new(AddressReservations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedMachineFeatures) DeepCopyInto(out *AdvancedMachineFeatures) {
+	*out = *in
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedMachineFeatures.
+func (in *AdvancedMachineFeatures) DeepCopy() *AdvancedMachineFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedMachineFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasIPRange) DeepCopyInto(out *AliasIPRange) {
+	*out = *in
+	if in.SubnetworkRangeName != nil {
+		in, out := &in.SubnetworkRangeName, &out.SubnetworkRangeName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasIPRange.
+func (in *AliasIPRange) DeepCopy() *AliasIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachedDiskSpec) DeepCopyInto(out *AttachedDiskSpec) {
+	*out = *in
+	if in.DeviceType != nil {
+		in, out := &in.DeviceType, &out.DeviceType
+		*out = new(DiskType)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SourceSnapshot != nil {
+		in, out := &in.SourceSnapshot, &out.SourceSnapshot
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourcePolicies != nil {
+		in, out := &in.ResourcePolicies, &out.ResourcePolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedDiskSpec.
+func (in *AttachedDiskSpec) DeepCopy() *AttachedDiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachedDiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedCIDRBlocks != nil {
+		in, out := &in.AllowedCIDRBlocks, &out.AllowedCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSpec.
+func (in *BastionSpec) DeepCopy() *BastionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
+	*out = *in
+	if in.SelfLink != nil {
+		in, out := &in.SelfLink, &out.SelfLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionStatus.
+func (in *BastionStatus) DeepCopy() *BastionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildParams) DeepCopyInto(out *BuildParams) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildParams.
+func (in *BuildParams) DeepCopy() *BuildParams {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneAccessSpec) DeepCopyInto(out *ControlPlaneAccessSpec) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneAccessSpec.
+func (in *ControlPlaneAccessSpec) DeepCopy() *ControlPlaneAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomMachineType) DeepCopyInto(out *CustomMachineType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomMachineType.
+func (in *CustomMachineType) DeepCopy() *CustomMachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
+	*out = *in
+	if in.ManagedZone != nil {
+		in, out := &in.ManagedZone, &out.ManagedZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerRecordName != nil {
+		in, out := &in.APIServerRecordName, &out.APIServerRecordName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
+func (in *DNSSpec) DeepCopy() *DNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCluster) DeepCopyInto(out *GCPCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCluster.
+func (in *GCPCluster) DeepCopy() *GCPCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterList) DeepCopyInto(out *GCPClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterList.
+func (in *GCPClusterList) DeepCopy() *GCPClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterSpec) DeepCopyInto(out *GCPClusterSpec) {
+	*out = *in
+	if in.ResourceNamePrefix != nil {
+		in, out := &in.ResourceNamePrefix, &out.ResourceNamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	in.Network.DeepCopyInto(&out.Network)
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(NetworkTier)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneAccess != nil {
+		in, out := &in.ControlPlaneAccess, &out.ControlPlaneAccess
+		*out = new(ControlPlaneAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PlacementPolicies != nil {
+		in, out := &in.PlacementPolicies, &out.PlacementPolicies
+		*out = make([]PlacementPolicySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterSpec.
+func (in *GCPClusterSpec) DeepCopy() *GCPClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterStatus) DeepCopyInto(out *GCPClusterStatus) {
+	*out = *in
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1alpha4.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	in.Network.DeepCopyInto(&out.Network)
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterStatus.
+func (in *GCPClusterStatus) DeepCopy() *GCPClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplate) DeepCopyInto(out *GCPClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplate.
+func (in *GCPClusterTemplate) DeepCopy() *GCPClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateList) DeepCopyInto(out *GCPClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateList.
+func (in *GCPClusterTemplateList) DeepCopy() *GCPClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateResource) DeepCopyInto(out *GCPClusterTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateResource.
+func (in *GCPClusterTemplateResource) DeepCopy() *GCPClusterTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPClusterTemplateSpec) DeepCopyInto(out *GCPClusterTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPClusterTemplateSpec.
+func (in *GCPClusterTemplateSpec) DeepCopy() *GCPClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachine) DeepCopyInto(out *GCPMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachine.
+func (in *GCPMachine) DeepCopy() *GCPMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineList) DeepCopyInto(out *GCPMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineList.
+func (in *GCPMachineList) DeepCopy() *GCPMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineSpec) DeepCopyInto(out *GCPMachineSpec) {
+	*out = *in
+	if in.CustomMachineType != nil {
+		in, out := &in.CustomMachineType, &out.CustomMachineType
+		*out = new(CustomMachineType)
+		**out = **in
+	}
+	if in.MinCPUPlatform != nil {
+		in, out := &in.MinCPUPlatform, &out.MinCPUPlatform
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvancedMachineFeatures != nil {
+		in, out := &in.AdvancedMachineFeatures, &out.AdvancedMachineFeatures
+		*out = new(AdvancedMachineFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestAccelerators != nil {
+		in, out := &in.GuestAccelerators, &out.GuestAccelerators
+		*out = make([]GuestAccelerator, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(NetworkTier)
+		**out = **in
+	}
+	if in.AddressReservations != nil {
+		in, out := &in.AddressReservations, &out.AddressReservations
+		*out = new(AddressReservations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageFamily != nil {
+		in, out := &in.ImageFamily, &out.ImageFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageLookup != nil {
+		in, out := &in.ImageLookup, &out.ImageLookup
+		*out = new(ImageLookup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageFamilyTemplate != nil {
+		in, out := &in.ImageFamilyTemplate, &out.ImageFamilyTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AdditionalMetadata != nil {
+		in, out := &in.AdditionalMetadata, &out.AdditionalMetadata
+		*out = make([]MetadataItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalNetworkTags != nil {
+		in, out := &in.AdditionalNetworkTags, &out.AdditionalNetworkTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootDeviceType != nil {
+		in, out := &in.RootDeviceType, &out.RootDeviceType
+		*out = new(DiskType)
+		**out = **in
+	}
+	if in.AdditionalDisks != nil {
+		in, out := &in.AdditionalDisks, &out.AdditionalDisks
+		*out = make([]AttachedDiskSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccount)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAffinities != nil {
+		in, out := &in.NodeAffinities, &out.NodeAffinities
+		*out = make([]NodeAffinity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlacementPolicy != nil {
+		in, out := &in.PlacementPolicy, &out.PlacementPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservationAffinity != nil {
+		in, out := &in.ReservationAffinity, &out.ReservationAffinity
+		*out = new(ReservationAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapDataBucket != nil {
+		in, out := &in.BootstrapDataBucket, &out.BootstrapDataBucket
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineSpec.
+func (in *GCPMachineSpec) DeepCopy() *GCPMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineStatus) DeepCopyInto(out *GCPMachineStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceStatus != nil {
+		in, out := &in.InstanceStatus, &out.InstanceStatus
+		*out = new(InstanceStatus)
+		**out = **in
+	}
+	if in.InstanceName != nil {
+		in, out := &in.InstanceName, &out.InstanceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapDataSecret != nil {
+		in, out := &in.BootstrapDataSecret, &out.BootstrapDataSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapDataObject != nil {
+		in, out := &in.BootstrapDataObject, &out.BootstrapDataObject
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedInternalAddress != nil {
+		in, out := &in.ReservedInternalAddress, &out.ReservedInternalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedExternalAddress != nil {
+		in, out := &in.ReservedExternalAddress, &out.ReservedExternalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(errors.MachineStatusError)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineStatus.
+func (in *GCPMachineStatus) DeepCopy() *GCPMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineTemplate) DeepCopyInto(out *GCPMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineTemplate.
+func (in *GCPMachineTemplate) DeepCopy() *GCPMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineTemplateList) DeepCopyInto(out *GCPMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineTemplateList.
+func (in *GCPMachineTemplateList) DeepCopy() *GCPMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineTemplateResource) DeepCopyInto(out *GCPMachineTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineTemplateResource.
+func (in *GCPMachineTemplateResource) DeepCopy() *GCPMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineTemplateSpec) DeepCopyInto(out *GCPMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineTemplateSpec.
+func (in *GCPMachineTemplateSpec) DeepCopy() *GCPMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPMachineTemplateStatus) DeepCopyInto(out *GCPMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPMachineTemplateStatus.
+func (in *GCPMachineTemplateStatus) DeepCopy() *GCPMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAccelerator) DeepCopyInto(out *GuestAccelerator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAccelerator.
+func (in *GuestAccelerator) DeepCopy() *GuestAccelerator {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAccelerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLookup) DeepCopyInto(out *ImageLookup) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	if in.FamilyTemplate != nil {
+		in, out := &in.FamilyTemplate, &out.FamilyTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameFilter != nil {
+		in, out := &in.NameFilter, &out.NameFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BaseOS != nil {
+		in, out := &in.BaseOS, &out.BaseOS
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLookup.
+func (in *ImageLookup) DeepCopy() *ImageLookup {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLookup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Labels) DeepCopyInto(out *Labels) {
+	{
+		in := &in
+		*out = make(Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Labels.
+func (in Labels) DeepCopy() Labels {
+	if in == nil {
+		return nil
+	}
+	out := new(Labels)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.APIServerAddress != nil {
+		in, out := &in.APIServerAddress, &out.APIServerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(ProxyHeader)
+		**out = **in
+	}
+	if in.BackendTimeoutSec != nil {
+		in, out := &in.BackendTimeoutSec, &out.BackendTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConnectionDrainingTimeoutSec != nil {
+		in, out := &in.ConnectionDrainingTimeoutSec, &out.ConnectionDrainingTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(SessionAffinity)
+		**out = **in
+	}
+	if in.BalancingMode != nil {
+		in, out := &in.BalancingMode, &out.BalancingMode
+		*out = new(BalancingMode)
+		**out = **in
+	}
+	if in.MaxConnectionsPerInstance != nil {
+		in, out := &in.MaxConnectionsPerInstance, &out.MaxConnectionsPerInstance
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SecurityPolicy != nil {
+		in, out := &in.SecurityPolicy, &out.SecurityPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataItem) DeepCopyInto(out *MetadataItem) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataItem.
+func (in *MetadataItem) DeepCopy() *MetadataItem {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	if in.SelfLink != nil {
+		in, out := &in.SelfLink, &out.SelfLink
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerAddress != nil {
+		in, out := &in.APIServerAddress, &out.APIServerAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIP != nil {
+		in, out := &in.APIServerIP, &out.APIServerIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerHealthCheck != nil {
+		in, out := &in.APIServerHealthCheck, &out.APIServerHealthCheck
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerInstanceGroups != nil {
+		in, out := &in.APIServerInstanceGroups, &out.APIServerInstanceGroups
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.APIServerBackendService != nil {
+		in, out := &in.APIServerBackendService, &out.APIServerBackendService
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerTargetProxy != nil {
+		in, out := &in.APIServerTargetProxy, &out.APIServerTargetProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerForwardingRule != nil {
+		in, out := &in.APIServerForwardingRule, &out.APIServerForwardingRule
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIPv6Address != nil {
+		in, out := &in.APIServerIPv6Address, &out.APIServerIPv6Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIPv6 != nil {
+		in, out := &in.APIServerIPv6, &out.APIServerIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIServerIPv6ForwardingRule != nil {
+		in, out := &in.APIServerIPv6ForwardingRule, &out.APIServerIPv6ForwardingRule
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkIP != nil {
+		in, out := &in.NetworkIP, &out.NetworkIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasIPRanges != nil {
+		in, out := &in.AliasIPRanges, &out.AliasIPRanges
+		*out = make([]AliasIPRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NicType != nil {
+		in, out := &in.NicType, &out.NicType
+		*out = new(NicType)
+		**out = **in
+	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(StackType)
+		**out = **in
+	}
+	if in.IPv6PublicIP != nil {
+		in, out := &in.IPv6PublicIP, &out.IPv6PublicIP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoCreateSubnetworks != nil {
+		in, out := &in.AutoCreateSubnetworks, &out.AutoCreateSubnetworks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(Subnets, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LoadBalancerBackendPort != nil {
+		in, out := &in.LoadBalancerBackendPort, &out.LoadBalancerBackendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.APIServerStackType != nil {
+		in, out := &in.APIServerStackType, &out.APIServerStackType
+		*out = new(StackType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAffinity) DeepCopyInto(out *NodeAffinity) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeGroup != nil {
+		in, out := &in.NodeGroup, &out.NodeGroup
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAffinity.
+func (in *NodeAffinity) DeepCopy() *NodeAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicySpec) DeepCopyInto(out *PlacementPolicySpec) {
+	*out = *in
+	if in.AvailabilityDomainCount != nil {
+		in, out := &in.AvailabilityDomainCount, &out.AvailabilityDomainCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VMCount != nil {
+		in, out := &in.VMCount, &out.VMCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicySpec.
+func (in *PlacementPolicySpec) DeepCopy() *PlacementPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationAffinity) DeepCopyInto(out *ReservationAffinity) {
+	*out = *in
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationAffinity.
+func (in *ReservationAffinity) DeepCopy() *ReservationAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
+func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryCidrBlocks != nil {
+		in, out := &in.SecondaryCidrBlocks, &out.SecondaryCidrBlocks
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PrivateGoogleAccess != nil {
+		in, out := &in.PrivateGoogleAccess, &out.PrivateGoogleAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableFlowLogs != nil {
+		in, out := &in.EnableFlowLogs, &out.EnableFlowLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(StackType)
+		**out = **in
+	}
+	if in.IPv6AccessType != nil {
+		in, out := &in.IPv6AccessType, &out.IPv6AccessType
+		*out = new(IPv6AccessType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
+func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Subnets) DeepCopyInto(out *Subnets) {
+	{
+		in := &in
+		*out = make(Subnets, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnets.
+func (in Subnets) DeepCopy() Subnets {
+	if in == nil {
+		return nil
+	}
+	out := new(Subnets)
+	in.DeepCopyInto(out)
+	return *out
+}
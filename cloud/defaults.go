@@ -19,4 +19,9 @@ package cloud
 const (
 	// ProviderIDPrefix is the gce provider id prefix.
 	ProviderIDPrefix = "gce://"
+
+	// BootstrapDataFormatCloudConfig is the format of cloud-init bootstrap data.
+	BootstrapDataFormatCloudConfig = "cloud-config"
+	// BootstrapDataFormatIgnition is the format of Ignition bootstrap data, used by Flatcar and Fedora CoreOS.
+	BootstrapDataFormatIgnition = "ignition"
 )
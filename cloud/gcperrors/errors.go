@@ -19,10 +19,17 @@ package gcperrors
 
 import (
 	"net/http"
+	"strings"
 
 	"google.golang.org/api/googleapi"
 )
 
+const (
+	// zoneResourcePoolExhausted is the error code returned when a zone doesn't have
+	// enough resources available to fulfil the request.
+	zoneResourcePoolExhausted = "ZONE_RESOURCE_POOL_EXHAUSTED"
+)
+
 // IsNotFound reports whether err is a Google API error
 // with http.StatusNotFround.
 func IsNotFound(err error) bool {
@@ -43,3 +50,21 @@ func IgnoreNotFound(err error) error {
 
 	return err
 }
+
+// IsZoneResourcePoolExhausted reports whether err is a Google API error
+// caused by a zone running out of resources.
+func IsZoneResourcePoolExhausted(err error) bool {
+	ae, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+
+	for _, e := range ae.Errors {
+		if strings.HasPrefix(e.Reason, zoneResourcePoolExhausted) {
+			return true
+		}
+	}
+
+	// Errors of failed operations only carry the code in the message.
+	return strings.Contains(ae.Message, zoneResourcePoolExhausted)
+}
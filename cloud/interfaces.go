@@ -20,9 +20,13 @@ import (
 	"context"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/storage/v1"
 
 	corev1 "k8s.io/api/core/v1"
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 )
@@ -30,6 +34,15 @@ import (
 // Cloud alias for cloud.Cloud interface.
 type Cloud = cloud.Cloud
 
+// CloudService alias for cloud.Service, making the calls of the cloud.
+type CloudService = cloud.Service
+
+// RateLimiter alias for cloud.RateLimiter interface.
+type RateLimiter = cloud.RateLimiter
+
+// RateLimitKey alias for cloud.RateLimitKey.
+type RateLimitKey = cloud.RateLimitKey
+
 // Reconciler is a generic interface used by components offering a type of service.
 type Reconciler interface {
 	Reconcile(ctx context.Context) error
@@ -39,6 +52,10 @@ type Reconciler interface {
 // Client is an interface which can get cloud client.
 type Client interface {
 	Cloud() Cloud
+	ComputeClient() *compute.Service
+	SecretManagerClient() *secretmanager.Service
+	StorageClient() *storage.Service
+	DNSClient() *dns.Service
 }
 
 // ClusterGetter is an interface which can get cluster informations.
@@ -48,10 +65,13 @@ type ClusterGetter interface {
 	Region() string
 	Name() string
 	Namespace() string
+	ResourceNamePrefix() string
 	NetworkName() string
+	NetworkTier() infrav1.NetworkTier
 	Network() *infrav1.Network
 	AdditionalLabels() infrav1.Labels
 	FailureDomains() clusterv1.FailureDomains
+	PlacementPolicies() []infrav1.PlacementPolicySpec
 	ControlPlaneEndpoint() clusterv1.APIEndpoint
 }
 
@@ -71,19 +91,24 @@ type MachineGetter interface {
 	Client
 	Name() string
 	Namespace() string
+	InstanceName() string
 	Zone() string
+	FailureDomainFallback() bool
+	FailureDomainCandidates(ctx context.Context) ([]string, error)
 	Role() string
 	IsControlPlane() bool
 	ControlPlaneGroupName() string
 	GetInstanceID() *string
 	GetProviderID() string
 	GetBootstrapData() (string, error)
+	GetBootstrapDataWithFormat() (string, string, error)
 	GetInstanceStatus() *infrav1.InstanceStatus
 }
 
 // MachineSetter is an interface which can set machine informations.
 type MachineSetter interface {
 	SetProviderID()
+	SetFailureDomain(zone string)
 	SetInstanceStatus(v infrav1.InstanceStatus)
 	SetFailureMessage(v error)
 	SetFailureReason(v capierrors.MachineStatusError)
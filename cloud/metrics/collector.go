@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+const (
+	namespaceLabel      = "namespace"
+	clusterLabel        = "cluster"
+	instanceStatusLabel = "instance_status"
+
+	// unknownInstanceStatus is the instance status of the machines whose instance wasn't observed yet.
+	unknownInstanceStatus = "UNKNOWN"
+)
+
+var (
+	machinesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "machines"),
+		"Number of GCPMachines per cluster and instance status.",
+		[]string{namespaceLabel, clusterLabel, instanceStatusLabel}, nil,
+	)
+
+	loadBalancerReadyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "cluster", "load_balancer_ready"),
+		"Whether the API server load balancer of the cluster is ready (1) or not (0).",
+		[]string{namespaceLabel, clusterLabel}, nil,
+	)
+)
+
+// Register registers the collector of the GCPMachine and GCPCluster metrics, which reads the objects from c at
+// scrape time, on the controller-runtime metrics registry.
+func Register(c client.Reader) error {
+	return ctrlmetrics.Registry.Register(&resourceCollector{client: c})
+}
+
+// resourceCollector is a prometheus.Collector of the state of the GCPMachines and GCPClusters.
+type resourceCollector struct {
+	client client.Reader
+}
+
+var _ prometheus.Collector = &resourceCollector{}
+
+// Describe implements prometheus.Collector.
+func (c *resourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- machinesDesc
+	ch <- loadBalancerReadyDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *resourceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	c.collectMachines(ctx, ch)
+	c.collectClusters(ctx, ch)
+}
+
+type machinesKey struct {
+	namespace      string
+	cluster        string
+	instanceStatus string
+}
+
+func (c *resourceCollector) collectMachines(ctx context.Context, ch chan<- prometheus.Metric) {
+	machines := &infrav1.GCPMachineList{}
+	if err := c.client.List(ctx, machines); err != nil {
+		ch <- prometheus.NewInvalidMetric(machinesDesc, err)
+		return
+	}
+
+	counts := map[machinesKey]int{}
+	for _, machine := range machines.Items {
+		status := unknownInstanceStatus
+		if machine.Status.InstanceStatus != nil {
+			status = string(*machine.Status.InstanceStatus)
+		}
+
+		counts[machinesKey{
+			namespace:      machine.Namespace,
+			cluster:        machine.Labels[clusterv1.ClusterLabelName],
+			instanceStatus: status,
+		}]++
+	}
+
+	for key, count := range counts {
+		ch <- prometheus.MustNewConstMetric(machinesDesc, prometheus.GaugeValue, float64(count), key.namespace, key.cluster, key.instanceStatus)
+	}
+}
+
+func (c *resourceCollector) collectClusters(ctx context.Context, ch chan<- prometheus.Metric) {
+	clusters := &infrav1.GCPClusterList{}
+	if err := c.client.List(ctx, clusters); err != nil {
+		ch <- prometheus.NewInvalidMetric(loadBalancerReadyDesc, err)
+		return
+	}
+
+	for _, cluster := range clusters.Items {
+		name := cluster.Labels[clusterv1.ClusterLabelName]
+		if name == "" {
+			name = cluster.Name
+		}
+
+		ready := 0.0
+		if cluster.Status.Network.APIServerForwardingRule != nil && cluster.Status.Network.APIServerIP != nil {
+			ready = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(loadBalancerReadyDesc, prometheus.GaugeValue, ready, cluster.Namespace, name)
+	}
+}
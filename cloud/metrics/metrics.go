@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/api/googleapi"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "capg"
+
+	serviceLabel   = "service"
+	operationLabel = "operation"
+	projectLabel   = "project"
+	reasonLabel    = "reason"
+)
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gcp_api",
+		Name:      "requests_total",
+		Help:      "Total number of GCP API calls per service, operation and project.",
+	}, []string{serviceLabel, operationLabel, projectLabel})
+
+	apiRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gcp_api",
+		Name:      "request_errors_total",
+		Help:      "Total number of failed GCP API calls per service, operation, project and error reason.",
+	}, []string{serviceLabel, operationLabel, projectLabel, reasonLabel})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gcp_api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the GCP API calls per service, operation and project.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{serviceLabel, operationLabel, projectLabel})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(apiRequests, apiRequestErrors, apiRequestDuration)
+}
+
+// observer records the metrics of the calls to a GCP API service.
+type observer struct {
+	service string
+	project string
+}
+
+// observe records a call to operation which started at start and returned the error pointed to by err.
+func (o observer) observe(operation string, start time.Time, err *error) {
+	apiRequestDuration.WithLabelValues(o.service, operation, o.project).Observe(time.Since(start).Seconds())
+	apiRequests.WithLabelValues(o.service, operation, o.project).Inc()
+	if *err != nil {
+		o.observeError(operation, *err)
+	}
+}
+
+// observeError records an error of operation, which was already recorded as a call.
+func (o observer) observeError(operation string, err error) {
+	apiRequestErrors.WithLabelValues(o.service, operation, o.project, errorReason(err)).Inc()
+}
+
+// errorReason returns the googleapi reason of err, falling back to its HTTP status code.
+func errorReason(err error) string {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return "unknown"
+	}
+
+	if len(gerr.Errors) > 0 && gerr.Errors[0].Reason != "" {
+		return gerr.Errors[0].Reason
+	}
+
+	return strconv.Itoa(gerr.Code)
+}
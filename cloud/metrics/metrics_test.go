@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/api/googleapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport(t *testing.T) {
+	notFound := `{"error": {"code": 404, "message": "not found", "errors": [{"reason": "notFound"}]}}`
+	networks := map[string]bool{}
+	c := &http.Client{Transport: NewTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		name := path.Base(req.URL.Path)
+		switch {
+		case req.Method == http.MethodPost:
+			networks["my-network"] = true
+		case !networks[name]:
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(notFound))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}), "metrics-proj")}
+
+	get := func() error {
+		resp, err := c.Get("https://compute.googleapis.com/compute/v1/projects/metrics-proj/global/networks/my-network")
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		return googleapi.CheckResponse(resp)
+	}
+
+	err := get()
+	if got := errorReason(err); got != "notFound" {
+		t.Fatalf("get network error reason = %v, want notFound", got)
+	}
+	resp, err := c.Post("https://compute.googleapis.com/compute/v1/projects/metrics-proj/global/networks", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("insert network error = %v", err)
+	}
+	resp.Body.Close()
+	if err := get(); err != nil {
+		t.Fatalf("get network error = %v", err)
+	}
+
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute.networks", "Get", "metrics-proj")); got != 2 {
+		t.Errorf("requests of compute.networks.Get = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute.networks", "Insert", "metrics-proj")); got != 1 {
+		t.Errorf("requests of compute.networks.Insert = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(apiRequestErrors.WithLabelValues("compute.networks", "Get", "metrics-proj", "notFound")); got != 1 {
+		t.Errorf("errors of compute.networks.Get = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(apiRequestDuration); got != 2 {
+		t.Errorf("duration series = %v, want 2", got)
+	}
+}
+
+func TestTransport_FailedOperation(t *testing.T) {
+	const (
+		instances = "https://compute.googleapis.com/compute/v1/projects/operations-proj/zones/us-central1-a/instances"
+		operation = "https://compute.googleapis.com/compute/v1/projects/operations-proj/zones/us-central1-a/operations/operation-1"
+	)
+	pending := `{"kind": "compute#operation", "name": "operation-1", "status": "PENDING", "operationType": "insert",
+		"targetLink": "https://www.googleapis.com/compute/v1/projects/operations-proj/zones/us-central1-a/instances/my-instance"}`
+	failed := `{"kind": "compute#operation", "name": "operation-1", "status": "DONE", "operationType": "insert",
+		"targetLink": "https://www.googleapis.com/compute/v1/projects/operations-proj/zones/us-central1-a/instances/my-instance",
+		"error": {"errors": [{"code": "ZONE_RESOURCE_POOL_EXHAUSTED", "message": "The zone does not have enough resources."}]}}`
+	polls := 0
+	c := &http.Client{Transport: NewTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := pending
+		if req.Method == http.MethodGet {
+			if polls++; polls == 3 {
+				body = failed
+			}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}), "operations-proj")}
+
+	send := func(req func() (*http.Response, error)) string {
+		resp, err := req()
+		if err != nil {
+			t.Fatalf("request error = %v", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body error = %v", err)
+		}
+		return string(body)
+	}
+
+	send(func() (*http.Response, error) { return c.Post(instances, "application/json", strings.NewReader("{}")) })
+	for i := 0; i < 3; i++ {
+		if got := send(func() (*http.Response, error) { return c.Get(operation) }); i == 2 && got != failed {
+			t.Fatalf("poll body = %v, want %v", got, failed)
+		}
+	}
+
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute.instances", "Insert", "operations-proj")); got != 1 {
+		t.Errorf("requests of compute.instances.Insert = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(apiRequestErrors.WithLabelValues("compute.instances", "Insert", "operations-proj", "ZONE_RESOURCE_POOL_EXHAUSTED")); got != 1 {
+		t.Errorf("errors of compute.instances.Insert = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute.operations", "Get", "operations-proj")); got != 0 {
+		t.Errorf("requests of compute.operations.Get = %v, want 0", got)
+	}
+}
+
+func TestAPIMethod(t *testing.T) {
+	tests := []struct {
+		method        string
+		url           string
+		wantService   string
+		wantOperation string
+	}{
+		{http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/instances/my-instance", "compute.instances", "Get"},
+		{http.MethodPost, "https://compute.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/instances", "compute.instances", "Insert"},
+		{http.MethodPost, "https://compute.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/instances/my-instance/setLabels", "compute.instances", "SetLabels"},
+		{http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/my-proj/regions/us-central1/forwardingRules?filter=x", "compute.forwardingRules", "List"},
+		{http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/my-proj/aggregated/addresses", "compute.addresses", "AggregatedList"},
+		{http.MethodPost, "https://compute.googleapis.com/compute/beta/projects/my-proj/global/securityPolicies/my-policy/patchRule?priority=1", "compute.securityPolicies", "PatchRule"},
+		{http.MethodDelete, "https://compute.googleapis.com/compute/v1/projects/my-proj/global/networks/my-network", "compute.networks", "Delete"},
+		{http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/operations/operation-1", "compute.operations", "Get"},
+		{http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/my-proj/zones/us-central1-a/machineTypes/n1-standard-2", "compute.machineTypes", "Get"},
+		{http.MethodGet, "https://dns.googleapis.com/dns/v1/projects/my-proj/managedZones/my-zone/rrsets?name=api", "dns.rrsets", "List"},
+		{http.MethodPost, "https://dns.googleapis.com/dns/v1/projects/my-proj/managedZones/my-zone/changes", "dns.changes", "Insert"},
+		{http.MethodPost, "https://secretmanager.googleapis.com/v1/projects/my-proj/secrets/my-secret:addVersion", "secretmanager.secrets", "AddVersion"},
+		{http.MethodGet, "https://secretmanager.googleapis.com/v1/projects/my-proj/secrets/my-secret/versions/latest:access", "secretmanager.versions", "Access"},
+		{http.MethodPost, "https://storage.googleapis.com/upload/storage/v1/b/my-bucket/o?name=bootstrap", "storage.objects", "Insert"},
+		{http.MethodDelete, "https://storage.googleapis.com/storage/v1/b/my-bucket/o/bootstrap", "storage.objects", "Delete"},
+		{http.MethodPost, "https://storage.googleapis.com/storage/v1/b?project=my-proj", "storage.buckets", "Insert"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service, operation := apiMethod(req)
+			if service != tt.wantService || operation != tt.wantOperation {
+				t.Errorf("apiMethod() = %v, %v, want %v, %v", service, operation, tt.wantService, tt.wantOperation)
+			}
+		})
+	}
+}
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "googleapi error with a reason",
+			err:  &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}},
+			want: "rateLimitExceeded",
+		},
+		{
+			name: "wrapped googleapi error without a reason",
+			err:  errors.Wrap(&googleapi.Error{Code: 404}, "failed to get network"),
+			want: "404",
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorReason(tt.err); got != tt.want {
+				t.Errorf("errorReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	running := infrav1.InstanceStatusRunning
+	machine := func(name string, status *infrav1.InstanceStatus) *infrav1.GCPMachine {
+		return &infrav1.GCPMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "my-cluster"},
+			},
+			Status: infrav1.GCPMachineStatus{InstanceStatus: status},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		machine("my-machine-0", &running),
+		machine("my-machine-1", &running),
+		machine("my-machine-2", nil),
+		&infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1.ClusterLabelName: "my-cluster"},
+			},
+			Status: infrav1.GCPClusterStatus{
+				Network: infrav1.Network{
+					APIServerForwardingRule: pointer.String("my-cluster-apiserver"),
+					APIServerIP:             pointer.String("10.0.0.1"),
+				},
+			},
+		},
+		&infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-other-cluster",
+				Namespace: "default",
+			},
+		},
+	).Build()
+
+	want := `
+# HELP capg_cluster_load_balancer_ready Whether the API server load balancer of the cluster is ready (1) or not (0).
+# TYPE capg_cluster_load_balancer_ready gauge
+capg_cluster_load_balancer_ready{cluster="my-cluster",namespace="default"} 1
+capg_cluster_load_balancer_ready{cluster="my-other-cluster",namespace="default"} 0
+# HELP capg_machines Number of GCPMachines per cluster and instance status.
+# TYPE capg_machines gauge
+capg_machines{cluster="my-cluster",instance_status="RUNNING",namespace="default"} 2
+capg_machines{cluster="my-cluster",instance_status="UNKNOWN",namespace="default"} 1
+`
+	if err := testutil.CollectAndCompare(&resourceCollector{client: c}, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+var (
+	// apiVersion matches the version segment of the path of the GCP API requests, e.g. "v1" or "beta".
+	apiVersion = regexp.MustCompile(`^(v\d+\w*|alpha|beta)$`)
+
+	// parentCollections are the collections of the GCP APIs used by the provider whose resources
+	// contain other collections, e.g. the zones of "projects/my-proj/zones/us-central1-a/instances".
+	parentCollections = map[string]bool{
+		"projects":     true,
+		"regions":      true,
+		"zones":        true,
+		"locations":    true,
+		"managedZones": true,
+		"secrets":      true,
+		"b":            true,
+	}
+
+	// collectionNames are the names of the collections abbreviated in the request paths.
+	collectionNames = map[string]string{
+		"b": "buckets",
+		"o": "objects",
+	}
+)
+
+// Transport is an http.RoundTripper recording the metrics of the GCP API requests made on behalf of a project.
+type Transport struct {
+	base    http.RoundTripper
+	project string
+}
+
+var _ http.RoundTripper = &Transport{}
+
+// NewTransport returns a Transport recording the metrics of the requests sent through base on behalf of project.
+func NewTransport(base http.RoundTripper, project string) *Transport {
+	return &Transport{
+		base:    base,
+		project: project,
+	}
+}
+
+// RoundTrip sends the request with the base transport and records its metrics.
+// The polls of the compute operations are part of the calls which started them, so they're only recorded
+// when they fail, and the errors of the failed operations are recorded against the calls which started them.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	service, operation := apiMethod(req)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	callErr := err
+	if err == nil {
+		callErr = responseError(resp)
+	}
+
+	poll := service == "compute.operations" && (operation == "Get" || operation == "Wait")
+	if !poll || callErr != nil {
+		observer{service: service, project: t.project}.observe(operation, start, &callErr)
+	}
+
+	if callErr == nil && strings.HasPrefix(service, "compute.") && (poll || req.Method != http.MethodGet) {
+		t.observeOperationError(req, resp)
+	}
+
+	return resp, err
+}
+
+// computeOperation is the part of a compute operation describing its outcome.
+type computeOperation struct {
+	Kind          string `json:"kind"`
+	Status        string `json:"status"`
+	OperationType string `json:"operationType"`
+	TargetLink    string `json:"targetLink"`
+	Error         *struct {
+		Errors []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+// observeOperationError records the error of the compute operation returned in resp, if it's done and failed,
+// against the call which started it, e.g. "Insert" of "compute.instances" for an instance insertion which
+// failed with ZONE_RESOURCE_POOL_EXHAUSTED. Its body is left readable by the caller.
+func (t *Transport) observeOperationError(req *http.Request, resp *http.Response) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	var op computeOperation
+	if err := json.Unmarshal(body, &op); err != nil || op.Kind != "compute#operation" || op.Status != "DONE" || op.Error == nil || op.OperationType == "" {
+		return
+	}
+
+	target, err := url.Parse(op.TargetLink)
+	if err != nil {
+		return
+	}
+	target.Host = req.URL.Host
+	service, _ := apiMethod(&http.Request{Method: http.MethodGet, URL: target})
+
+	opErr := &googleapi.Error{Code: resp.StatusCode}
+	for _, e := range op.Error.Errors {
+		opErr.Errors = append(opErr.Errors, googleapi.ErrorItem{Reason: e.Code, Message: e.Message})
+	}
+	observer{service: service, project: t.project}.observeError(strings.ToUpper(op.OperationType[:1])+op.OperationType[1:], opErr)
+}
+
+// responseError returns the googleapi error of an unsuccessful response, leaving its body readable by the caller.
+func responseError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	return googleapi.CheckResponse(&http.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	})
+}
+
+// apiMethod returns the service, e.g. "compute.instances", and the operation, e.g. "Insert", of a GCP API request.
+// The path of the request alternates collections and resource names after the API version, and ends with the
+// custom method of compute resources, e.g. "instances/my-instance/setLabels", or of other resources, e.g.
+// "secrets/my-secret:addVersion".
+func apiMethod(req *http.Request) (string, string) {
+	api := strings.SplitN(req.URL.Host, ".", 2)[0]
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		if apiVersion.MatchString(segment) {
+			segments = segments[i+1:]
+			break
+		}
+	}
+
+	var parts []string
+	var customMethod string
+	aggregated := false
+	for _, segment := range segments {
+		switch segment {
+		case "", "global":
+			continue
+		case "aggregated":
+			aggregated = true
+			continue
+		}
+
+		if i := strings.LastIndex(segment, ":"); i >= 0 {
+			segment, customMethod = segment[:i], segment[i+1:]
+		}
+		parts = append(parts, segment)
+	}
+
+	if customMethod == "" && len(parts) >= 3 && len(parts)%2 == 1 && !parentCollections[parts[len(parts)-3]] {
+		customMethod = parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+
+	var collection string
+	named := len(parts)%2 == 0
+	switch {
+	case len(parts) == 0:
+	case named:
+		collection = parts[len(parts)-2]
+	default:
+		collection = parts[len(parts)-1]
+	}
+
+	if name, ok := collectionNames[collection]; ok {
+		collection = name
+	}
+
+	service := api
+	if collection != "" {
+		service += "." + collection
+	}
+
+	return service, operation(req.Method, customMethod, named, aggregated)
+}
+
+// operation returns the name of the operation of a request with the given HTTP method on a named resource or a collection.
+func operation(method, customMethod string, named, aggregated bool) string {
+	if customMethod != "" {
+		return strings.ToUpper(customMethod[:1]) + customMethod[1:]
+	}
+
+	switch {
+	case method == http.MethodGet && named:
+		return "Get"
+	case method == http.MethodGet && aggregated:
+		return "AggregatedList"
+	case method == http.MethodGet:
+		return "List"
+	case method == http.MethodPost && !named:
+		return "Insert"
+	case method == http.MethodPut:
+		return "Update"
+	}
+
+	return strings.ToUpper(method[:1]) + strings.ToLower(method[1:])
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// secretManagerPayloadDecoder extracts the gzipped bootstrap data from a Secret Manager access response.
+const secretManagerPayloadDecoder = `tr -d '\n' | sed -E 's/.*"data": *"([^"]+)".*/\1/' | base64 -d | gunzip`
+
+// bootstrapDataFetchScript is the user-data of instances storing their cloud-config bootstrap data outside of
+// the instance metadata. It fetches the bootstrap data from the given URL with the instance service account,
+// decodes it with the given command, and seeds it as the user-data of a NoCloud datasource with the id and the
+// hostname of the instance. As cloud-init already used the semaphores of the instance to run this script, its
+// state is cleaned before running it again, so that the bootstrap data is processed like any user-data.
+// The seed is only readable by root and is deleted once processed, as the bootstrap data contains secrets.
+// cloud-init can't fetch #include URLs with the credentials of the instance, hence the seed.
+// The instance service account must have the cloud-platform scope to be able to access the bootstrap data.
+const bootstrapDataFetchScript = `#!/bin/bash
+set -o errexit
+set -o nounset
+set -o pipefail
+
+STATE_DIR=/var/lib/capg
+SEED_DIR="${STATE_DIR}/seed"
+if [ -f "${STATE_DIR}/bootstrapped" ]; then
+  exit 0
+fi
+
+METADATA_URL="http://metadata.google.internal/computeMetadata/v1/instance"
+BOOTSTRAP_DATA_URL="%s"
+
+metadata() {
+  curl -sSf -H "Metadata-Flavor: Google" "${METADATA_URL}/$1"
+}
+
+TOKEN=""
+for i in $(seq 1 30); do
+  TOKEN=$(metadata service-accounts/default/token | sed -E 's/.*"access_token": *"([^"]+)".*/\1/') && break
+  sleep 10
+done
+
+umask 077
+rm -rf "${SEED_DIR}"
+install -d -m 0700 "${SEED_DIR}"
+trap 'rm -rf "${SEED_DIR}"' EXIT
+
+FETCHED=""
+for i in $(seq 1 30); do
+  install -m 0600 /dev/null "${SEED_DIR}/user-data"
+  curl -sSf -H "Authorization: Bearer ${TOKEN}" "${BOOTSTRAP_DATA_URL}" | %s > "${SEED_DIR}/user-data" && FETCHED=true && break
+  sleep 10
+done
+
+if [ -z "${FETCHED}" ]; then
+  echo "failed to fetch the bootstrap data" >&2
+  exit 1
+fi
+
+cat > "${SEED_DIR}/meta-data" <<EOF
+instance-id: $(metadata id)
+local-hostname: $(metadata hostname)
+EOF
+
+cat > "${SEED_DIR}/cloud.cfg" <<EOF
+datasource_list: [NoCloud]
+datasource:
+  NoCloud:
+    seedfrom: file://${SEED_DIR}/
+EOF
+
+cloud-init clean
+cloud-init --file "${SEED_DIR}/cloud.cfg" init
+cloud-init --file "${SEED_DIR}/cloud.cfg" modules --mode=config
+cloud-init --file "${SEED_DIR}/cloud.cfg" modules --mode=final
+
+touch "${STATE_DIR}/bootstrapped"
+`
+
+// ignitionReplaceConfig returns an Ignition config replacing itself with the config at the given source,
+// using the same Ignition spec version as the given bootstrap data.
+// Ignition fetches gs:// URLs with the credentials of the instance service account.
+func ignitionReplaceConfig(bootstrapData, source string) (string, error) {
+	var config struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal([]byte(bootstrapData), &config); err != nil {
+		return "", errors.Wrap(err, "failed to parse ignition bootstrap data")
+	}
+
+	if config.Ignition.Version == "" {
+		return "", errors.New("ignition bootstrap data doesn't specify a spec version")
+	}
+
+	replace := map[string]interface{}{
+		"ignition": map[string]interface{}{
+			"version": config.Ignition.Version,
+			"config": map[string]interface{}{
+				"replace": map[string]interface{}{
+					"source": source,
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(replace)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal ignition config")
+	}
+
+	return string(data), nil
+}
@@ -18,26 +18,53 @@ package scope
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/storage/v1"
+	htransport "google.golang.org/api/transport/http"
 
 	"k8s.io/client-go/util/flowcontrol"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/metrics"
 )
 
 // GCPServices contains all the gcp services used by the scopes.
 type GCPServices struct {
-	Compute     *compute.Service
-	ComputeBeta *computebeta.Service
+	Compute       *compute.Service
+	ComputeBeta   *computebeta.Service
+	SecretManager *secretmanager.Service
+	Storage       *storage.Service
+	DNS           *dns.Service
+}
+
+// dnsServices are the services of the Cloud DNS API, whose calls share dnsRateLimiter.
+var dnsServices = map[string]bool{
+	"ManagedZones":       true,
+	"ResourceRecordSets": true,
+	"Changes":            true,
 }
 
+// dnsRateLimiter limits the calls to the Cloud DNS API made for all the clusters, so that reconciling
+// many clusters doesn't exhaust its quota.
+var dnsRateLimiter = flowcontrol.NewTokenBucketRateLimiter(5, 5)
+
 // GCPRateLimiter implements cloud.RateLimiter.
 type GCPRateLimiter struct{}
 
 // Accept blocks until the operation can be performed.
 func (rl *GCPRateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey) error {
+	if dnsServices[key.Service] {
+		rl := &cloud.AcceptRateLimiter{Acceptor: dnsRateLimiter}
+		return rl.Accept(ctx, key)
+	}
+
 	if key.Operation == "Get" && key.Service == "Operations" {
 		// Wait a minimum amount of time regardless of rate limiter.
 		rl := &cloud.MinimumRateLimiter{
@@ -53,11 +80,26 @@ func (rl *GCPRateLimiter) Accept(ctx context.Context, key *cloud.RateLimitKey) e
 	return nil
 }
 
-func newCloud(project string, service GCPServices) cloud.Cloud {
-	return cloud.NewGCE(&cloud.Service{
+func newCloudService(project string, service GCPServices) *cloud.Service {
+	return &cloud.Service{
 		GA:            service.Compute,
 		Beta:          service.ComputeBeta,
 		ProjectRouter: &cloud.SingleProjectRouter{ID: project},
 		RateLimiter:   &GCPRateLimiter{},
-	})
+	}
+}
+
+func newCloud(project string, service GCPServices) cloud.Cloud {
+	return cloud.NewGCE(newCloudService(project, service))
+}
+
+// newHTTPClient returns an authenticated client for the GCP services, recording the metrics of the requests
+// made on behalf of project.
+func newHTTPClient(ctx context.Context, project string) (*http.Client, error) {
+	transport, err := htransport.NewTransport(ctx, metrics.NewTransport(http.DefaultTransport, project), option.WithScopes(compute.CloudPlatformScope))
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: transport}, nil
 }
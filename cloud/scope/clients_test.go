@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+func TestGCPRateLimiter_Accept_DNS(t *testing.T) {
+	dnsRateLimiter = flowcontrol.NewTokenBucketRateLimiter(1, 2)
+	rl := &GCPRateLimiter{}
+	accept := func(service string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		return rl.Accept(ctx, &cloud.RateLimitKey{ProjectID: "my-proj", Operation: "Get", Version: "v1", Service: service})
+	}
+
+	for _, service := range []string{"ManagedZones", "ResourceRecordSets"} {
+		if err := accept(service); err != nil {
+			t.Fatalf("Accept(%v) error = %v, want the burst to be accepted", service, err)
+		}
+	}
+
+	if err := accept("Changes"); err == nil {
+		t.Error("Accept(Changes) error = nil, want the call to be throttled once the burst is consumed")
+	}
+
+	if err := accept("Instances"); err != nil {
+		t.Errorf("Accept(Instances) error = %v, want compute calls not to be throttled", err)
+	}
+}
@@ -19,22 +19,51 @@ package scope
 import (
 	"context"
 	"fmt"
+	"math"
+	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/storage/v1"
 
 	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/naming"
 )
 
+// placementPolicyName returns the name of the resource policy of a placement policy of the cluster.
+func placementPolicyName(prefix, name string) string {
+	return naming.Name(prefix, name)
+}
+
+// resourceNamePrefix returns the prefix of the names of the GCP resources of a cluster without one.
+// Clusters created before the prefix was recorded keep their resources named after the cluster.
+func resourceNamePrefix(cluster *clusterv1.Cluster, gcpCluster *infrav1.GCPCluster) string {
+	if gcpCluster.Status.Ready || gcpCluster.Status.Network.SelfLink != nil {
+		return cluster.Name
+	}
+
+	return naming.Unique(cluster.Namespace, cluster.Name, naming.MaxPrefixLength)
+}
+
+// computeNetworkTier returns the compute API value of a network tier, e.g. "STANDARD".
+func computeNetworkTier(tier infrav1.NetworkTier) string {
+	return strings.ToUpper(string(tier))
+}
+
 // ClusterScopeParams defines the input parameters used to create a new Scope.
 type ClusterScopeParams struct {
 	GCPServices
@@ -53,16 +82,37 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		return nil, errors.New("failed to generate new scope from nil GCPCluster")
 	}
 
-	computeSvc, err := compute.NewService(context.TODO())
+	// All the services share the client recording the metrics of the GCP API calls.
+	httpClient, err := newHTTPClient(context.TODO(), params.GCPCluster.Spec.Project)
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp http client: %v", err)
+	}
+
+	computeSvc, err := compute.NewService(context.TODO(), option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, errors.Errorf("failed to create gcp compute client: %v", err)
 	}
 
-	computeBetaSvc, err := computebeta.NewService(context.TODO())
+	computeBetaSvc, err := computebeta.NewService(context.TODO(), option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, errors.Errorf("failed to create gcp compute beta client: %v", err)
 	}
 
+	secretManagerSvc, err := secretmanager.NewService(context.TODO(), option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp secret manager client: %v", err)
+	}
+
+	storageSvc, err := storage.NewService(context.TODO(), option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp storage client: %v", err)
+	}
+
+	dnsSvc, err := dns.NewService(context.TODO(), option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp dns client: %v", err)
+	}
+
 	if params.GCPServices.Compute == nil {
 		params.GCPServices.Compute = computeSvc
 	}
@@ -71,11 +121,32 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.GCPServices.ComputeBeta = computeBetaSvc
 	}
 
+	if params.GCPServices.SecretManager == nil {
+		params.GCPServices.SecretManager = secretManagerSvc
+	}
+
+	if params.GCPServices.Storage == nil {
+		params.GCPServices.Storage = storageSvc
+	}
+
+	if params.GCPServices.DNS == nil {
+		params.GCPServices.DNS = dnsSvc
+	}
+
+	// GCPClusters stored before their defaults were materialized by the webhook are defaulted
+	// before taking the patch snapshot, so that only changes made by the reconciliation get patched.
+	params.GCPCluster.Default()
+
 	helper, err := patch.NewHelper(params.GCPCluster, params.Client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init patch helper")
 	}
 
+	// The prefix is recorded in the spec, as the status isn't preserved when the cluster is moved.
+	if params.GCPCluster.Spec.ResourceNamePrefix == nil {
+		params.GCPCluster.Spec.ResourceNamePrefix = pointer.String(resourceNamePrefix(params.Cluster, params.GCPCluster))
+	}
+
 	return &ClusterScope{
 		client:      params.Client,
 		Cluster:     params.Cluster,
@@ -102,6 +173,36 @@ func (s *ClusterScope) Cloud() cloud.Cloud {
 	return newCloud(s.Project(), s.GCPServices)
 }
 
+// CloudService returns the service making the calls of the cloud, for the calls the cloud doesn't support.
+func (s *ClusterScope) CloudService() *cloud.CloudService {
+	return newCloudService(s.Project(), s.GCPServices)
+}
+
+// RateLimiter returns the rate limiter of the calls to the GCP APIs not made through the cloud.
+func (s *ClusterScope) RateLimiter() cloud.RateLimiter {
+	return &GCPRateLimiter{}
+}
+
+// ComputeClient returns initialized compute client.
+func (s *ClusterScope) ComputeClient() *compute.Service {
+	return s.GCPServices.Compute
+}
+
+// SecretManagerClient returns initialized secret manager client.
+func (s *ClusterScope) SecretManagerClient() *secretmanager.Service {
+	return s.GCPServices.SecretManager
+}
+
+// StorageClient returns initialized storage client.
+func (s *ClusterScope) StorageClient() *storage.Service {
+	return s.GCPServices.Storage
+}
+
+// DNSClient returns initialized cloud dns client.
+func (s *ClusterScope) DNSClient() *dns.Service {
+	return s.GCPServices.DNS
+}
+
 // Project returns the current project name.
 func (s *ClusterScope) Project() string {
 	return s.GCPCluster.Spec.Project
@@ -122,9 +223,14 @@ func (s *ClusterScope) Namespace() string {
 	return s.Cluster.Namespace
 }
 
+// ResourceNamePrefix returns the prefix of the names of the GCP resources of the cluster.
+func (s *ClusterScope) ResourceNamePrefix() string {
+	return pointer.StringDeref(s.GCPCluster.Spec.ResourceNamePrefix, s.Name())
+}
+
 // NetworkName returns the cluster network unique identifier.
 func (s *ClusterScope) NetworkName() string {
-	return pointer.StringDeref(s.GCPCluster.Spec.Network.Name, "default")
+	return *s.GCPCluster.Spec.Network.Name
 }
 
 // Network returns the cluster network object.
@@ -137,18 +243,107 @@ func (s *ClusterScope) AdditionalLabels() infrav1.Labels {
 	return s.GCPCluster.Spec.AdditionalLabels
 }
 
+// NetworkTier returns the network tier of the cluster external addresses.
+func (s *ClusterScope) NetworkTier() infrav1.NetworkTier {
+	if s.GCPCluster.Spec.NetworkTier == nil {
+		return infrav1.NetworkTierPremium
+	}
+
+	return *s.GCPCluster.Spec.NetworkTier
+}
+
 // ControlPlaneEndpoint returns the cluster control-plane endpoint.
 func (s *ClusterScope) ControlPlaneEndpoint() clusterv1.APIEndpoint {
 	endpoint := s.GCPCluster.Spec.ControlPlaneEndpoint
-	endpoint.Port = pointer.Int32Deref(s.Cluster.Spec.ClusterNetwork.APIServerPort, 443)
+	endpoint.Port = s.apiServerPort()
 	return endpoint
 }
 
+// apiServerPort returns the port of the API server load balancer frontend. Regional
+// pass-through load balancers of the Standard tier don't translate ports, so they
+// use the backend port.
+func (s *ClusterScope) apiServerPort() int32 {
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		return s.apiServerBackendPort()
+	}
+
+	return pointer.Int32Deref(s.Cluster.Spec.ClusterNetwork.APIServerPort, 443)
+}
+
+// apiServerBackendPort returns the port of the API server load balancer backends.
+func (s *ClusterScope) apiServerBackendPort() int32 {
+	return pointer.Int32Deref(s.GCPCluster.Spec.Network.LoadBalancerBackendPort, 6443)
+}
+
 // FailureDomains returns the cluster failure domains.
 func (s *ClusterScope) FailureDomains() clusterv1.FailureDomains {
 	return s.GCPCluster.Status.FailureDomains
 }
 
+// PlacementPolicies returns the placement policies of the cluster.
+func (s *ClusterScope) PlacementPolicies() []infrav1.PlacementPolicySpec {
+	return s.GCPCluster.Spec.PlacementPolicies
+}
+
+// Bastion returns the bastion host spec of the cluster, if any.
+func (s *ClusterScope) Bastion() *infrav1.BastionSpec {
+	return s.GCPCluster.Spec.Bastion
+}
+
+// BastionStatus returns the observed state of the bastion host, if any.
+func (s *ClusterScope) BastionStatus() *infrav1.BastionStatus {
+	return s.GCPCluster.Status.Bastion
+}
+
+// BastionZone returns the zone of the bastion host: the observed zone if it exists, the configured
+// zone, or the first failure domain of the cluster.
+func (s *ClusterScope) BastionZone() string {
+	if status := s.BastionStatus(); status != nil && status.Zone != "" {
+		return status.Zone
+	}
+
+	if bastion := s.Bastion(); bastion != nil && bastion.Zone != nil {
+		return *bastion.Zone
+	}
+
+	zones := make([]string, 0, len(s.FailureDomains()))
+	for zone := range s.FailureDomains() {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	if len(zones) == 0 {
+		return ""
+	}
+
+	return zones[0]
+}
+
+// DNS returns the DNS spec of the control plane endpoint, if any.
+func (s *ClusterScope) DNS() *infrav1.DNSSpec {
+	return s.GCPCluster.Spec.DNS
+}
+
+// APIServerDNSName returns the DNS name of the control plane endpoint, or an empty string
+// when the DNS name isn't managed.
+func (s *ClusterScope) APIServerDNSName() string {
+	spec := s.DNS()
+	if spec == nil {
+		return ""
+	}
+
+	record := pointer.StringDeref(spec.APIServerRecordName, fmt.Sprintf("api.%s", s.Name()))
+	return fmt.Sprintf("%s.%s", record, strings.TrimSuffix(spec.Domain, "."))
+}
+
+// ManagedZoneName returns the name of the managed zone publishing the control plane endpoint.
+func (s *ClusterScope) ManagedZoneName() string {
+	if spec := s.DNS(); spec != nil && spec.ManagedZone != nil {
+		return *spec.ManagedZone
+	}
+
+	return s.ResourceNamePrefix()
+}
+
 // ANCHOR_END: ClusterGetter
 
 // ANCHOR: ClusterSetter
@@ -168,16 +363,21 @@ func (s *ClusterScope) SetControlPlaneEndpoint(endpoint clusterv1.APIEndpoint) {
 	s.GCPCluster.Spec.ControlPlaneEndpoint = endpoint
 }
 
+// SetBastionStatus sets the observed state of the bastion host.
+func (s *ClusterScope) SetBastionStatus(status *infrav1.BastionStatus) {
+	s.GCPCluster.Status.Bastion = status
+}
+
 // ANCHOR_END: ClusterSetter
 
 // ANCHOR: ClusterNetworkSpec
 
 // NetworkSpec returns google compute network spec.
 func (s *ClusterScope) NetworkSpec() *compute.Network {
-	createSubnet := pointer.BoolDeref(s.GCPCluster.Spec.Network.AutoCreateSubnetworks, true)
+	createSubnet := *s.GCPCluster.Spec.Network.AutoCreateSubnetworks
 	network := &compute.Network{
 		Name:                  s.NetworkName(),
-		Description:           infrav1.ClusterTagKey(s.Name()),
+		Description:           infrav1.ClusterTagKey(s.ResourceNamePrefix()),
 		AutoCreateSubnetworks: createSubnet,
 	}
 
@@ -188,10 +388,10 @@ func (s *ClusterScope) NetworkSpec() *compute.Network {
 func (s *ClusterScope) NatRouterSpec() *compute.Router {
 	networkSpec := s.NetworkSpec()
 	return &compute.Router{
-		Name: fmt.Sprintf("%s-%s", networkSpec.Name, "router"),
+		Name: naming.Name(networkSpec.Name, "router"),
 		Nats: []*compute.RouterNat{
 			{
-				Name:                          fmt.Sprintf("%s-%s", networkSpec.Name, "nat"),
+				Name:                          naming.Name(networkSpec.Name, "nat"),
 				NatIpAllocateOption:           "AUTO_ONLY",
 				SourceSubnetworkIpRangesToNat: "ALL_SUBNETWORKS_ALL_IP_RANGES",
 			},
@@ -199,6 +399,61 @@ func (s *ClusterScope) NatRouterSpec() *compute.Router {
 	}
 }
 
+// SubnetworksSpec returns google compute subnetworks spec.
+func (s *ClusterScope) SubnetworksSpec() []*compute.Subnetwork {
+	subnetworks := make([]*compute.Subnetwork, 0, len(s.GCPCluster.Spec.Network.Subnets))
+	for _, subnet := range s.GCPCluster.Spec.Network.Subnets {
+		region := subnet.Region
+		if region == "" {
+			region = s.Region()
+		}
+
+		subnetwork := &compute.Subnetwork{
+			Name:                  subnet.Name,
+			Region:                region,
+			IpCidrRange:           subnet.CidrBlock,
+			Description:           pointer.StringDeref(subnet.Description, infrav1.ClusterTagKey(s.ResourceNamePrefix())),
+			PrivateIpGoogleAccess: pointer.BoolDeref(subnet.PrivateGoogleAccess, false),
+			EnableFlowLogs:        pointer.BoolDeref(subnet.EnableFlowLogs, false),
+		}
+
+		if subnet.StackType != nil {
+			subnetwork.StackType = string(*subnet.StackType)
+		}
+		if subnet.IPv6AccessType != nil {
+			subnetwork.Ipv6AccessType = string(*subnet.IPv6AccessType)
+		}
+
+		// Sort the secondary ranges for a deterministic spec.
+		names := make([]string, 0, len(subnet.SecondaryCidrBlocks))
+		for name := range subnet.SecondaryCidrBlocks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			subnetwork.SecondaryIpRanges = append(subnetwork.SecondaryIpRanges, &compute.SubnetworkSecondaryRange{
+				RangeName:   name,
+				IpCidrRange: subnet.SecondaryCidrBlocks[name],
+			})
+		}
+
+		subnetworks = append(subnetworks, subnetwork)
+	}
+
+	return subnetworks
+}
+
+// dualStack reports whether the cluster has a dual-stack subnetwork.
+func (s *ClusterScope) dualStack() bool {
+	for _, subnet := range s.GCPCluster.Spec.Network.Subnets {
+		if subnet.StackType != nil && *subnet.StackType == infrav1.StackTypeIPv4IPv6 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ANCHOR_END: ClusterNetworkSpec
 
 // ANCHOR: ClusterFirewallSpec
@@ -208,7 +463,7 @@ func (s *ClusterScope) FirewallRulesSpec() []*compute.Firewall {
 	network := s.Network()
 	firewallRules := []*compute.Firewall{
 		{
-			Name:    fmt.Sprintf("allow-%s-healthchecks", s.Name()),
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "healthchecks"),
 			Network: *network.SelfLink,
 			Allowed: []*compute.FirewallAllowed{
 				{
@@ -224,11 +479,11 @@ func (s *ClusterScope) FirewallRulesSpec() []*compute.Firewall {
 				"130.211.0.0/22",
 			},
 			TargetTags: []string{
-				fmt.Sprintf("%s-control-plane", s.Name()),
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
 			},
 		},
 		{
-			Name:    fmt.Sprintf("allow-%s-cluster", s.Name()),
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "cluster"),
 			Network: *network.SelfLink,
 			Allowed: []*compute.FirewallAllowed{
 				{
@@ -237,59 +492,286 @@ func (s *ClusterScope) FirewallRulesSpec() []*compute.Firewall {
 			},
 			Direction: "INGRESS",
 			SourceTags: []string{
-				fmt.Sprintf("%s-control-plane", s.Name()),
-				fmt.Sprintf("%s-node", s.Name()),
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
+				naming.Name(s.ResourceNamePrefix(), "node"),
 			},
 			TargetTags: []string{
-				fmt.Sprintf("%s-control-plane", s.Name()),
-				fmt.Sprintf("%s-node", s.Name()),
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
+				naming.Name(s.ResourceNamePrefix(), "node"),
 			},
 		},
 	}
 
+	// IPv6 health checks come from their own ranges, which can't be mixed with IPv4 ranges in a rule.
+	if s.dualStack() {
+		firewallRules = append(firewallRules, &compute.Firewall{
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "healthchecks-ipv6"),
+			Network: *network.SelfLink,
+			Allowed: []*compute.FirewallAllowed{
+				{
+					IPProtocol: "TCP",
+					Ports: []string{
+						strconv.FormatInt(6443, 10),
+					},
+				},
+			},
+			Direction: "INGRESS",
+			SourceRanges: []string{
+				"2600:2d00:1:b029::/64",
+				"2600:2d00:1:1::/64",
+			},
+			TargetTags: []string{
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
+			},
+		})
+	}
+
+	// Regional pass-through load balancers of the Standard tier preserve the client addresses,
+	// and are health checked from additional ranges.
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		firewallRules[0].SourceRanges = append(firewallRules[0].SourceRanges, "209.85.152.0/22", "209.85.204.0/22")
+		firewallRules = append(firewallRules, &compute.Firewall{
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "apiserver"),
+			Network: *network.SelfLink,
+			Allowed: []*compute.FirewallAllowed{
+				{
+					IPProtocol: "TCP",
+					Ports: []string{
+						strconv.FormatInt(int64(s.apiServerBackendPort()), 10),
+					},
+				},
+			},
+			Direction:    "INGRESS",
+			SourceRanges: s.controlPlaneAllowedCIDRs(),
+			TargetTags: []string{
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
+			},
+		})
+	}
+
 	return firewallRules
 }
 
+// controlPlaneAllowedCIDRs returns the source ranges allowed to connect to the control plane endpoint.
+func (s *ClusterScope) controlPlaneAllowedCIDRs() []string {
+	if access := s.GCPCluster.Spec.ControlPlaneAccess; access != nil && len(access.AllowedCIDRs) > 0 {
+		return access.AllowedCIDRs
+	}
+
+	return []string{"0.0.0.0/0"}
+}
+
 // ANCHOR_END: ClusterFirewallSpec
 
 // ANCHOR: ClusterControlPlaneSpec
 
 // AddressSpec returns google compute address spec.
 func (s *ClusterScope) AddressSpec() *compute.Address {
-	return &compute.Address{
-		Name:        fmt.Sprintf("%s-%s", s.Name(), infrav1.APIServerRoleTagValue),
+	address := &compute.Address{
+		Name:        naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue),
 		AddressType: "EXTERNAL",
 		IpVersion:   "IPV4",
+		NetworkTier: computeNetworkTier(s.NetworkTier()),
+	}
+
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		address.Region = s.Region()
+	}
+
+	return address
+}
+
+// APIServerStackType returns the IP stack of the API server load balancer frontend.
+func (s *ClusterScope) APIServerStackType() infrav1.StackType {
+	if s.GCPCluster.Spec.Network.APIServerStackType == nil {
+		return infrav1.StackTypeIPv4Only
+	}
+
+	return *s.GCPCluster.Spec.Network.APIServerStackType
+}
+
+// AddressIPv6Spec returns google compute address spec of the IPv6 frontend of the API server.
+func (s *ClusterScope) AddressIPv6Spec() *compute.Address {
+	return &compute.Address{
+		Name:        naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue, "ipv6"),
+		AddressType: "EXTERNAL",
+		IpVersion:   "IPV6",
+		NetworkTier: computeNetworkTier(s.NetworkTier()),
 	}
 }
 
+// ForwardingRuleIPv6Spec returns google compute forwarding-rule spec of the IPv6 frontend of the API server.
+func (s *ClusterScope) ForwardingRuleIPv6Spec() *compute.ForwardingRule {
+	rule := s.ForwardingRuleSpec()
+	rule.Name = naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue, "ipv6")
+	rule.IpVersion = "IPV6"
+	return rule
+}
+
+// loadBalancer returns the API server load balancer spec, defaulting to an empty spec.
+func (s *ClusterScope) loadBalancer() *infrav1.LoadBalancerSpec {
+	if s.GCPCluster.Spec.LoadBalancer == nil {
+		return &infrav1.LoadBalancerSpec{}
+	}
+
+	return s.GCPCluster.Spec.LoadBalancer
+}
+
+// APIServerAddressRef returns the name or the IP address of the existing address used by the
+// API server load balancer, or an empty string when an address is reserved for the cluster.
+func (s *ClusterScope) APIServerAddressRef() string {
+	return pointer.StringDeref(s.loadBalancer().APIServerAddress, "")
+}
+
 // BackendServiceSpec returns google compute backend-service spec.
 func (s *ClusterScope) BackendServiceSpec() *compute.BackendService {
-	return &compute.BackendService{
-		Name:                fmt.Sprintf("%s-%s", s.Name(), infrav1.APIServerRoleTagValue),
+	lb := s.loadBalancer()
+	backendsvc := &compute.BackendService{
+		Name:                naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue),
 		LoadBalancingScheme: "EXTERNAL",
-		PortName:            "apiserver",
 		Protocol:            "TCP",
-		TimeoutSec:          int64((10 * time.Minute).Seconds()),
+		SessionAffinity:     string(infrav1.SessionAffinityNone),
+	}
+
+	if lb.SessionAffinity != nil {
+		backendsvc.SessionAffinity = string(*lb.SessionAffinity)
+	}
+
+	if lb.ConnectionDrainingTimeoutSec != nil {
+		backendsvc.ConnectionDraining = &compute.ConnectionDraining{
+			DrainingTimeoutSec: *lb.ConnectionDrainingTimeoutSec,
+		}
+	}
+
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		backendsvc.Region = s.Region()
+		return backendsvc
+	}
+
+	backendsvc.PortName = "apiserver"
+	backendsvc.TimeoutSec = pointer.Int64Deref(lb.BackendTimeoutSec, int64((10 * time.Minute).Seconds()))
+	return backendsvc
+}
+
+// BackendSpec returns the spec of the backend service backend of an instance group.
+func (s *ClusterScope) BackendSpec(instanceGroup string) *compute.Backend {
+	lb := s.loadBalancer()
+	backend := &compute.Backend{
+		BalancingMode: string(infrav1.BalancingModeUtilization),
+		Group:         instanceGroup,
+	}
+
+	// Pass-through load balancers only support the connection balancing mode.
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		backend.BalancingMode = string(infrav1.BalancingModeConnection)
+		return backend
+	}
+
+	if lb.BalancingMode != nil {
+		backend.BalancingMode = string(*lb.BalancingMode)
+	}
+
+	if backend.BalancingMode == string(infrav1.BalancingModeConnection) {
+		backend.MaxConnectionsPerInstance = pointer.Int64Deref(lb.MaxConnectionsPerInstance, 0)
+	}
+
+	return backend
+}
+
+// SecurityPolicySpec returns the reference to the security policy attached to the backend service, if any:
+// either the policy of the load balancer spec, or the policy enforcing the control plane access.
+func (s *ClusterScope) SecurityPolicySpec() *compute.SecurityPolicyReference {
+	policy := s.loadBalancer().SecurityPolicy
+	if spec := s.APIServerSecurityPolicySpec(); spec != nil {
+		policy = &spec.Name
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	return &compute.SecurityPolicyReference{
+		SecurityPolicy: path.Join("projects", s.Project(), "global", "securityPolicies", *policy),
+	}
+}
+
+// APIServerSecurityPolicyName returns the name of the security policy enforcing the control plane access.
+func (s *ClusterScope) APIServerSecurityPolicyName() string {
+	return naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue)
+}
+
+// APIServerSecurityPolicySpec returns the spec of the security policy enforcing the control plane access,
+// or nil when the access isn't restricted or is enforced by the firewall.
+func (s *ClusterScope) APIServerSecurityPolicySpec() *computebeta.SecurityPolicy {
+	if s.GCPCluster.Spec.ControlPlaneAccess == nil || s.NetworkTier() == infrav1.NetworkTierStandard {
+		return nil
+	}
+
+	// Security policy rules match up to 10 source ranges each.
+	const maxSrcIPRanges = 10
+	cidrs := s.controlPlaneAllowedCIDRs()
+	rules := make([]*computebeta.SecurityPolicyRule, 0, len(cidrs)/maxSrcIPRanges+2)
+	for i := 0; i < len(cidrs); i += maxSrcIPRanges {
+		end := i + maxSrcIPRanges
+		if end > len(cidrs) {
+			end = len(cidrs)
+		}
+
+		rules = append(rules, &computebeta.SecurityPolicyRule{
+			Description: "Allow the control plane clients",
+			Action:      "allow",
+			Priority:    int64(1000 + i/maxSrcIPRanges),
+			Match: &computebeta.SecurityPolicyRuleMatcher{
+				VersionedExpr: "SRC_IPS_V1",
+				Config: &computebeta.SecurityPolicyRuleMatcherConfig{
+					SrcIpRanges: cidrs[i:end],
+				},
+			},
+		})
+	}
+
+	rules = append(rules, &computebeta.SecurityPolicyRule{
+		Description: "Deny all other clients",
+		Action:      "deny(403)",
+		Priority:    math.MaxInt32,
+		Match: &computebeta.SecurityPolicyRuleMatcher{
+			VersionedExpr: "SRC_IPS_V1",
+			Config: &computebeta.SecurityPolicyRuleMatcherConfig{
+				SrcIpRanges: []string{"*"},
+			},
+		},
+	})
+
+	return &computebeta.SecurityPolicy{
+		Name:        s.APIServerSecurityPolicyName(),
+		Description: infrav1.ClusterTagKey(s.ResourceNamePrefix()),
+		Rules:       rules,
 	}
 }
 
 // ForwardingRuleSpec returns google compute forwarding-rule spec.
 func (s *ClusterScope) ForwardingRuleSpec() *compute.ForwardingRule {
-	port := pointer.Int32Deref(s.Cluster.Spec.ClusterNetwork.APIServerPort, 443)
+	port := s.apiServerPort()
 	portRange := fmt.Sprintf("%d-%d", port, port)
-	return &compute.ForwardingRule{
-		Name:                fmt.Sprintf("%s-%s", s.Name(), infrav1.APIServerRoleTagValue),
+	rule := &compute.ForwardingRule{
+		Name:                naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue),
 		IPProtocol:          "TCP",
 		LoadBalancingScheme: "EXTERNAL",
 		PortRange:           portRange,
+		NetworkTier:         computeNetworkTier(s.NetworkTier()),
+	}
+
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		rule.Region = s.Region()
 	}
+
+	return rule
 }
 
 // HealthCheckSpec returns google compute health-check spec.
 func (s *ClusterScope) HealthCheckSpec() *compute.HealthCheck {
-	return &compute.HealthCheck{
-		Name: fmt.Sprintf("%s-%s", s.Name(), infrav1.APIServerRoleTagValue),
+	healthCheck := &compute.HealthCheck{
+		Name: naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue),
 		Type: "SSL",
 		SslHealthCheck: &compute.SSLHealthCheck{
 			Port:              6443,
@@ -300,13 +782,19 @@ func (s *ClusterScope) HealthCheckSpec() *compute.HealthCheck {
 		HealthyThreshold:   5,
 		UnhealthyThreshold: 3,
 	}
+
+	if s.NetworkTier() == infrav1.NetworkTierStandard {
+		healthCheck.Region = s.Region()
+	}
+
+	return healthCheck
 }
 
 // InstanceGroupSpec returns google compute instance-group spec.
 func (s *ClusterScope) InstanceGroupSpec(zone string) *compute.InstanceGroup {
-	port := pointer.Int32Deref(s.GCPCluster.Spec.Network.LoadBalancerBackendPort, 6443)
+	port := s.apiServerBackendPort()
 	return &compute.InstanceGroup{
-		Name: fmt.Sprintf("%s-%s-%s", s.Name(), infrav1.APIServerRoleTagValue, zone),
+		Name: naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue, zone),
 		NamedPorts: []*compute.NamedPort{
 			{
 				Name: "apiserver",
@@ -318,14 +806,232 @@ func (s *ClusterScope) InstanceGroupSpec(zone string) *compute.InstanceGroup {
 
 // TargetTCPProxySpec returns google compute target-tcp-proxy spec.
 func (s *ClusterScope) TargetTCPProxySpec() *computebeta.TargetTcpProxy {
+	proxyHeader := infrav1.ProxyHeaderNone
+	if header := s.loadBalancer().ProxyHeader; header != nil {
+		proxyHeader = *header
+	}
+
 	return &computebeta.TargetTcpProxy{
-		Name:        fmt.Sprintf("%s-%s", s.Name(), infrav1.APIServerRoleTagValue),
-		ProxyHeader: "NONE",
+		Name:        naming.Name(s.ResourceNamePrefix(), infrav1.APIServerRoleTagValue),
+		ProxyHeader: string(proxyHeader),
 	}
 }
 
 // ANCHOR_END: ClusterControlPlaneSpec
 
+// ANCHOR: ClusterResourcePolicySpec
+
+// ResourcePoliciesSpec returns the resource policies spec.
+func (s *ClusterScope) ResourcePoliciesSpec() []*compute.ResourcePolicy {
+	policies := make([]*compute.ResourcePolicy, 0, len(s.GCPCluster.Spec.PlacementPolicies))
+	for _, placement := range s.GCPCluster.Spec.PlacementPolicies {
+		groupPlacement := &compute.ResourcePolicyGroupPlacementPolicy{
+			Collocation:             "UNSPECIFIED_COLLOCATION",
+			AvailabilityDomainCount: pointer.Int64Deref(placement.AvailabilityDomainCount, 0),
+		}
+		if placement.Collocation == infrav1.PlacementPolicyCollocationCompact {
+			groupPlacement.Collocation = "COLLOCATED"
+			groupPlacement.VmCount = pointer.Int64Deref(placement.VMCount, 0)
+		}
+
+		policies = append(policies, &compute.ResourcePolicy{
+			Name:                 placementPolicyName(s.ResourceNamePrefix(), placement.Name),
+			Region:               s.Region(),
+			Description:          infrav1.ClusterTagKey(s.ResourceNamePrefix()),
+			GroupPlacementPolicy: groupPlacement,
+		})
+	}
+
+	return policies
+}
+
+// ANCHOR_END: ClusterResourcePolicySpec
+
+// ANCHOR: ClusterDNSSpec
+
+// ManagedZoneSpec returns the spec of the managed zone created for the cluster.
+func (s *ClusterScope) ManagedZoneSpec() *dns.ManagedZone {
+	spec := s.DNS()
+	zone := &dns.ManagedZone{
+		Name:        s.ManagedZoneName(),
+		DnsName:     strings.TrimSuffix(spec.Domain, ".") + ".",
+		Description: infrav1.ClusterTagKey(s.ResourceNamePrefix()),
+		Labels: infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ResourceNamePrefix(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Additional:  s.AdditionalLabels(),
+		}),
+		Visibility: "public",
+	}
+
+	if spec.Visibility != infrav1.DNSZoneVisibilityPrivate {
+		return zone
+	}
+
+	zone.Visibility = "private"
+	zone.PrivateVisibilityConfig = &dns.ManagedZonePrivateVisibilityConfig{
+		Networks: []*dns.ManagedZonePrivateVisibilityConfigNetwork{
+			{
+				NetworkUrl: *s.Network().SelfLink,
+			},
+		},
+	}
+
+	return zone
+}
+
+// APIServerRecordSetSpecs returns the specs of the records of the control plane endpoint: an A record,
+// and an AAAA record when the API server frontend is dual-stack.
+func (s *ClusterScope) APIServerRecordSetSpecs() []*dns.ResourceRecordSet {
+	records := []*dns.ResourceRecordSet{apiServerRecordSet(s.APIServerDNSName(), "A", s.Network().APIServerIP)}
+	if s.APIServerStackType() == infrav1.StackTypeIPv4IPv6 {
+		records = append(records, apiServerRecordSet(s.APIServerDNSName(), "AAAA", s.Network().APIServerIPv6))
+	}
+
+	return records
+}
+
+// apiServerRecordSet returns the spec of a record of the control plane endpoint, pointing to ip once reserved.
+func apiServerRecordSet(name, recordType string, ip *string) *dns.ResourceRecordSet {
+	record := &dns.ResourceRecordSet{
+		Name: name + ".",
+		Type: recordType,
+		Ttl:  300,
+	}
+
+	if ip != nil {
+		record.Rrdatas = []string{*ip}
+	}
+
+	return record
+}
+
+// ANCHOR_END: ClusterDNSSpec
+
+// ANCHOR: ClusterBastionSpec
+
+// bastionAccessMode returns the access mode of the bastion host.
+func (s *ClusterScope) bastionAccessMode() infrav1.BastionAccessMode {
+	if bastion := s.Bastion(); bastion != nil && bastion.AccessMode != "" {
+		return bastion.AccessMode
+	}
+
+	return infrav1.BastionAccessModeSSH
+}
+
+// BastionInstanceSpec returns the instance spec of the bastion host.
+func (s *ClusterScope) BastionInstanceSpec() *compute.Instance {
+	bastion := s.Bastion()
+	zone := s.BastionZone()
+
+	instanceType := "e2-micro"
+	if bastion.InstanceType != "" {
+		instanceType = bastion.InstanceType
+	}
+
+	image := "projects/debian-cloud/global/images/family/debian-11"
+	if bastion.Image != nil {
+		image = *bastion.Image
+	}
+
+	labels := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.ResourceNamePrefix(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        pointer.StringPtr(infrav1.BastionRoleTagValue),
+		Additional:  s.AdditionalLabels(),
+	})
+
+	networkInterface := &compute.NetworkInterface{
+		Network: path.Join("projects", s.Project(), "global", "networks", s.NetworkName()),
+	}
+	if bastion.Subnet != nil {
+		networkInterface.Subnetwork = path.Join("regions", s.Region(), "subnetworks", *bastion.Subnet)
+	}
+	if pointer.BoolDeref(bastion.PublicIP, s.bastionAccessMode() == infrav1.BastionAccessModeSSH) {
+		networkInterface.AccessConfigs = []*compute.AccessConfig{
+			{
+				Type:        "ONE_TO_ONE_NAT",
+				Name:        "External NAT",
+				NetworkTier: computeNetworkTier(s.NetworkTier()),
+			},
+		}
+	}
+
+	return &compute.Instance{
+		Name:        naming.Name(s.ResourceNamePrefix(), infrav1.BastionRoleTagValue),
+		Zone:        zone,
+		MachineType: infrav1.MachineTypeURL(zone, instanceType),
+		Tags: &compute.Tags{
+			Items: []string{
+				naming.Name(s.ResourceNamePrefix(), infrav1.BastionRoleTagValue),
+			},
+		},
+		Labels: labels,
+		Disks: []*compute.AttachedDisk{
+			{
+				AutoDelete: true,
+				Boot:       true,
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					DiskType:    path.Join("zones", zone, "diskTypes", string(infrav1.PdStandardDiskType)),
+					Labels:      labels,
+					SourceImage: image,
+				},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{networkInterface},
+	}
+}
+
+// BastionFirewallRulesSpec returns the firewall rules allowing SSH connections to the bastion host,
+// and from the bastion host to the nodes of the cluster.
+func (s *ClusterScope) BastionFirewallRulesSpec() []*compute.Firewall {
+	network := s.Network()
+	bastionTag := naming.Name(s.ResourceNamePrefix(), infrav1.BastionRoleTagValue)
+
+	// Identity-Aware Proxy TCP forwarding connects from a single well-known range.
+	sourceRanges := []string{"35.235.240.0/20"}
+	if s.bastionAccessMode() == infrav1.BastionAccessModeSSH {
+		sourceRanges = []string{"0.0.0.0/0"}
+		if bastion := s.Bastion(); bastion != nil && len(bastion.AllowedCIDRBlocks) > 0 {
+			sourceRanges = bastion.AllowedCIDRBlocks
+		}
+	}
+
+	return []*compute.Firewall{
+		{
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "bastion"),
+			Network: *network.SelfLink,
+			Allowed: []*compute.FirewallAllowed{
+				{
+					IPProtocol: "TCP",
+					Ports:      []string{"22"},
+				},
+			},
+			Direction:    "INGRESS",
+			SourceRanges: sourceRanges,
+			TargetTags:   []string{bastionTag},
+		},
+		{
+			Name:    naming.Name("allow", s.ResourceNamePrefix(), "bastion-nodes"),
+			Network: *network.SelfLink,
+			Allowed: []*compute.FirewallAllowed{
+				{
+					IPProtocol: "TCP",
+					Ports:      []string{"22"},
+				},
+			},
+			Direction:  "INGRESS",
+			SourceTags: []string{bastionTag},
+			TargetTags: []string{
+				naming.Name(s.ResourceNamePrefix(), "control-plane"),
+				naming.Name(s.ResourceNamePrefix(), "node"),
+			},
+		},
+	}
+}
+
+// ANCHOR_END: ClusterBastionSpec
+
 // PatchObject persists the cluster configuration and status.
 func (s *ClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(context.TODO(), s.GCPCluster)
@@ -20,21 +20,28 @@ package scope
 import (
 	"context"
 	"fmt"
+	neturl "net/url"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
-	"golang.org/x/mod/semver"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/secretmanager/v1"
+	"google.golang.org/api/storage/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/naming"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/cluster-api/controllers/mdutil"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util"
@@ -42,6 +49,33 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// nodeGroupAffinityKey is the sole-tenant node affinity key of node group names.
+	nodeGroupAffinityKey = "compute.googleapis.com/node-group-name"
+	// reservationAffinityKey is the reservation affinity key of reservation names.
+	reservationAffinityKey = "compute.googleapis.com/reservation-name"
+	// addressTypeInternal and addressTypeExternal are the types of static addresses.
+	addressTypeInternal = "INTERNAL"
+	addressTypeExternal = "EXTERNAL"
+)
+
+// instanceName returns the name of the GCP instance of a machine without a recorded one. Machines
+// with an instance, e.g. moved by clusterctl without their status, take it from their provider ID.
+// Machines created before the instance name was recorded keep their instance named after the GCPMachine.
+func instanceName(gcpMachine *infrav1.GCPMachine) string {
+	if gcpMachine.Spec.ProviderID != nil {
+		if parsed, err := noderefutil.NewProviderID(*gcpMachine.Spec.ProviderID); err == nil {
+			return parsed.ID()
+		}
+	}
+
+	if gcpMachine.Spec.ProviderID != nil || gcpMachine.Status.InstanceStatus != nil {
+		return gcpMachine.Name
+	}
+
+	return naming.Unique(gcpMachine.Namespace, gcpMachine.Name, naming.MaxLength)
+}
+
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
 type MachineScopeParams struct {
 	Client        client.Client
@@ -63,11 +97,19 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		return nil, errors.New("gcp machine is required when creating a MachineScope")
 	}
 
+	// GCPMachines stored before their defaults were materialized by the webhook are defaulted
+	// before taking the patch snapshot, so that only changes made by the reconciliation get patched.
+	params.GCPMachine.Default()
+
 	helper, err := patch.NewHelper(params.GCPMachine, params.Client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init patch helper")
 	}
 
+	if params.GCPMachine.Status.InstanceName == nil {
+		params.GCPMachine.Status.InstanceName = pointer.String(instanceName(params.GCPMachine))
+	}
+
 	return &MachineScope{
 		client:        params.Client,
 		Machine:       params.Machine,
@@ -93,8 +135,42 @@ func (m *MachineScope) Cloud() cloud.Cloud {
 	return m.ClusterGetter.Cloud()
 }
 
+// ComputeClient returns initialized compute client.
+func (m *MachineScope) ComputeClient() *compute.Service {
+	return m.ClusterGetter.ComputeClient()
+}
+
+// SecretManagerClient returns initialized secret manager client.
+func (m *MachineScope) SecretManagerClient() *secretmanager.Service {
+	return m.ClusterGetter.SecretManagerClient()
+}
+
+// StorageClient returns initialized storage client.
+func (m *MachineScope) StorageClient() *storage.Service {
+	return m.ClusterGetter.StorageClient()
+}
+
+// DNSClient returns initialized cloud dns client.
+func (m *MachineScope) DNSClient() *dns.Service {
+	return m.ClusterGetter.DNSClient()
+}
+
+// Project returns the project the GCPMachine belongs to.
+func (m *MachineScope) Project() string {
+	return m.ClusterGetter.Project()
+}
+
+// ResourceNamePrefix returns the prefix of the names of the GCP resources of the cluster the GCPMachine belongs to.
+func (m *MachineScope) ResourceNamePrefix() string {
+	return m.ClusterGetter.ResourceNamePrefix()
+}
+
 // Zone returns the FailureDomain for the GCPMachine.
 func (m *MachineScope) Zone() string {
+	if m.GCPMachine.Spec.FailureDomain != nil {
+		return *m.GCPMachine.Spec.FailureDomain
+	}
+
 	if m.Machine.Spec.FailureDomain == nil {
 		return ""
 	}
@@ -102,6 +178,69 @@ func (m *MachineScope) Zone() string {
 	return *m.Machine.Spec.FailureDomain
 }
 
+// FailureDomainFallback returns true if the instance can be created in another failure domain
+// when its zone runs out of resources.
+func (m *MachineScope) FailureDomainFallback() bool {
+	return m.GCPMachine.Spec.FailureDomainFallback
+}
+
+// FailureDomainCandidates returns the cluster failure domains the machine can be placed in,
+// ordered from the one hosting the fewest machines of the cluster to the one hosting the most.
+func (m *MachineScope) FailureDomainCandidates(ctx context.Context) ([]string, error) {
+	labels := client.MatchingLabels{clusterv1.ClusterLabelName: m.ClusterGetter.Name()}
+	machines := &clusterv1.MachineList{}
+	if err := m.client.List(ctx, machines, client.InNamespace(m.Namespace()), labels); err != nil {
+		return nil, errors.Wrap(err, "failed to list machines")
+	}
+
+	gcpMachines := &infrav1.GCPMachineList{}
+	if err := m.client.List(ctx, gcpMachines, client.InNamespace(m.Namespace()), labels); err != nil {
+		return nil, errors.Wrap(err, "failed to list gcp machines")
+	}
+
+	// Machines placed by the provider only get their failure domain once
+	// the Machine controller copied it over from the GCPMachine.
+	placed := make(map[string]string, len(gcpMachines.Items))
+	for _, gm := range gcpMachines.Items {
+		if gm.Spec.FailureDomain != nil {
+			placed[gm.Name] = *gm.Spec.FailureDomain
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, machine := range machines.Items {
+		if machine.Name == m.Machine.Name {
+			continue
+		}
+
+		zone := placed[machine.Spec.InfrastructureRef.Name]
+		if machine.Spec.FailureDomain != nil {
+			zone = *machine.Spec.FailureDomain
+		}
+
+		counts[zone]++
+	}
+
+	zones := make([]string, 0, len(m.ClusterGetter.FailureDomains()))
+	for zone, spec := range m.ClusterGetter.FailureDomains() {
+		if m.IsControlPlane() && !spec.ControlPlane {
+			continue
+		}
+
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		if counts[zones[i]] == counts[zones[j]] {
+			return zones[i] < zones[j]
+		}
+
+		return counts[zones[i]] < counts[zones[j]]
+	})
+
+	return zones, nil
+}
+
 // Name returns the GCPMachine name.
 func (m *MachineScope) Name() string {
 	return m.GCPMachine.Name
@@ -112,9 +251,14 @@ func (m *MachineScope) Namespace() string {
 	return m.GCPMachine.Namespace
 }
 
+// InstanceName returns the name of the GCP instance of the machine.
+func (m *MachineScope) InstanceName() string {
+	return pointer.StringDeref(m.GCPMachine.Status.InstanceName, m.Name())
+}
+
 // ControlPlaneGroupName returns the control-plane instance group name.
 func (m *MachineScope) ControlPlaneGroupName() string {
-	return fmt.Sprintf("%s-%s-%s", m.ClusterGetter.Name(), infrav1.APIServerRoleTagValue, m.Zone())
+	return naming.Name(m.ClusterGetter.ResourceNamePrefix(), infrav1.APIServerRoleTagValue, m.Zone())
 }
 
 // IsControlPlane returns true if the machine is a control plane.
@@ -150,16 +294,164 @@ func (m *MachineScope) GetProviderID() string {
 	return ""
 }
 
+// Version returns the Kubernetes version of the Machine, if any.
+func (m *MachineScope) Version() string {
+	return pointer.StringDeref(m.Machine.Spec.Version, "")
+}
+
+// ImageLookup returns the image lookup of the GCPMachine, if any.
+func (m *MachineScope) ImageLookup() *infrav1.ImageLookup {
+	if m.GCPMachine.Spec.Image != nil || m.GCPMachine.Spec.ImageFamily != nil {
+		return nil
+	}
+
+	return m.GCPMachine.Spec.ImageLookup
+}
+
+// GetImage returns the image resolved by the image lookup of the GCPMachine, if any.
+func (m *MachineScope) GetImage() *string {
+	return m.GCPMachine.Status.Image
+}
+
+// RolloutImage returns the image resolved for the other machines of the same MachineDeployment rollout, if any.
+func (m *MachineScope) RolloutImage(ctx context.Context) (*string, error) {
+	deployment, ok := m.Machine.Labels[clusterv1.MachineDeploymentLabelName]
+	if !ok {
+		return nil, nil
+	}
+
+	hash, ok := m.Machine.Labels[mdutil.DefaultMachineDeploymentUniqueLabelKey]
+	if !ok {
+		return nil, nil
+	}
+
+	machines := &clusterv1.MachineList{}
+	if err := m.client.List(ctx, machines, client.InNamespace(m.Namespace()), client.MatchingLabels{
+		clusterv1.ClusterLabelName:                    m.ClusterGetter.Name(),
+		clusterv1.MachineDeploymentLabelName:          deployment,
+		mdutil.DefaultMachineDeploymentUniqueLabelKey: hash,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list machines")
+	}
+
+	for _, machine := range machines.Items {
+		if machine.Name == m.Machine.Name {
+			continue
+		}
+
+		gcpMachine := &infrav1.GCPMachine{}
+		key := types.NamespacedName{Namespace: m.Namespace(), Name: machine.Spec.InfrastructureRef.Name}
+		if err := m.client.Get(ctx, key, gcpMachine); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get gcp machine %s", key)
+		}
+
+		if gcpMachine.Status.Image != nil {
+			return gcpMachine.Status.Image, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// HasNodeRef returns true if the Machine has been linked to a Node.
+func (m *MachineScope) HasNodeRef() bool {
+	return m.Machine.Status.NodeRef != nil
+}
+
+// BootstrapDataStorage returns where the bootstrap data of the instance is stored.
+func (m *MachineScope) BootstrapDataStorage() infrav1.BootstrapDataStorage {
+	if m.GCPMachine.Spec.BootstrapDataStorage == "" {
+		return infrav1.BootstrapDataStorageMetadata
+	}
+
+	return m.GCPMachine.Spec.BootstrapDataStorage
+}
+
+// BootstrapDataSecretName returns the name of the Secret Manager secret holding the bootstrap data.
+func (m *MachineScope) BootstrapDataSecretName() string {
+	return fmt.Sprintf("%s-%s-bootstrap", m.ClusterGetter.ResourceNamePrefix(), m.InstanceName())
+}
+
+// GetBootstrapDataSecret returns the Secret Manager secret holding the bootstrap data, if any.
+func (m *MachineScope) GetBootstrapDataSecret() *string {
+	return m.GCPMachine.Status.BootstrapDataSecret
+}
+
+// BootstrapDataBucket returns the name of the GCS bucket storing the bootstrap data.
+func (m *MachineScope) BootstrapDataBucket() string {
+	return pointer.StringDeref(m.GCPMachine.Spec.BootstrapDataBucket, "")
+}
+
+// BootstrapDataObjectName returns the name of the GCS object holding the bootstrap data.
+func (m *MachineScope) BootstrapDataObjectName() string {
+	return path.Join(m.ClusterGetter.ResourceNamePrefix(), m.InstanceName()+"-bootstrap")
+}
+
+// GetBootstrapDataObject returns the gs:// URL of the GCS object holding the bootstrap data, if any.
+func (m *MachineScope) GetBootstrapDataObject() *string {
+	return m.GCPMachine.Status.BootstrapDataObject
+}
+
+// InstanceServiceAccountEmail returns the email of the instance service account,
+// resolving the Compute Engine default service account of the project.
+func (m *MachineScope) InstanceServiceAccountEmail(ctx context.Context) (string, error) {
+	email := m.InstanceServiceAccountsSpec().Email
+	if email != "" && email != "default" {
+		return email, nil
+	}
+
+	project, err := m.Cloud().Projects().Get(ctx, m.ClusterGetter.Project())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve project to resolve the default service account")
+	}
+
+	return fmt.Sprintf("%d-compute@developer.gserviceaccount.com", project.Id), nil
+}
+
+// AddressReservation returns the reservation of the static address of the given type, INTERNAL or EXTERNAL, if any.
+func (m *MachineScope) AddressReservation(addressType string) *infrav1.AddressReservation {
+	reservations := m.GCPMachine.Spec.AddressReservations
+	if reservations == nil {
+		return nil
+	}
+
+	switch addressType {
+	case addressTypeInternal:
+		return reservations.Internal
+	case addressTypeExternal:
+		return reservations.External
+	default:
+		return nil
+	}
+}
+
+// NetworkTier returns the network tier of the external addresses of the instance.
+func (m *MachineScope) NetworkTier() infrav1.NetworkTier {
+	if m.GCPMachine.Spec.NetworkTier != nil {
+		return *m.GCPMachine.Spec.NetworkTier
+	}
+
+	return m.ClusterGetter.NetworkTier()
+}
+
 // ANCHOR_END: MachineGetter
 
 // ANCHOR: MachineSetter
 
 // SetProviderID sets the GCPMachine providerID in spec.
 func (m *MachineScope) SetProviderID() {
-	providerID := cloud.ProviderIDPrefix + path.Join(m.ClusterGetter.Project(), m.Zone(), m.Name())
+	providerID := cloud.ProviderIDPrefix + path.Join(m.ClusterGetter.Project(), m.Zone(), m.InstanceName())
 	m.GCPMachine.Spec.ProviderID = pointer.StringPtr(providerID)
 }
 
+// SetFailureDomain records the zone the GCPMachine instance is placed in.
+func (m *MachineScope) SetFailureDomain(zone string) {
+	m.GCPMachine.Spec.FailureDomain = pointer.StringPtr(zone)
+}
+
 // GetInstanceStatus returns the GCPMachine instance status.
 func (m *MachineScope) GetInstanceStatus() *infrav1.InstanceStatus {
 	return m.GCPMachine.Status.InstanceStatus
@@ -170,6 +462,21 @@ func (m *MachineScope) SetInstanceStatus(v infrav1.InstanceStatus) {
 	m.GCPMachine.Status.InstanceStatus = &v
 }
 
+// SetImage sets the image resolved by the image lookup of the GCPMachine.
+func (m *MachineScope) SetImage(image string) {
+	m.GCPMachine.Status.Image = pointer.StringPtr(image)
+}
+
+// SetBootstrapDataSecret sets the Secret Manager secret holding the bootstrap data.
+func (m *MachineScope) SetBootstrapDataSecret(v *string) {
+	m.GCPMachine.Status.BootstrapDataSecret = v
+}
+
+// SetBootstrapDataObject sets the gs:// URL of the GCS object holding the bootstrap data.
+func (m *MachineScope) SetBootstrapDataObject(v *string) {
+	m.GCPMachine.Status.BootstrapDataObject = v
+}
+
 // SetReady sets the GCPMachine Ready Status.
 func (m *MachineScope) SetReady() {
 	m.GCPMachine.Status.Ready = true
@@ -198,23 +505,39 @@ func (m *MachineScope) SetAddresses(addressList []corev1.NodeAddress) {
 	m.GCPMachine.Status.Addresses = addressList
 }
 
+// SetReservedAddress sets the static address of the given type, INTERNAL or EXTERNAL, reserved for the instance.
+func (m *MachineScope) SetReservedAddress(addressType string, v *string) {
+	switch addressType {
+	case addressTypeInternal:
+		m.GCPMachine.Status.ReservedInternalAddress = v
+	case addressTypeExternal:
+		m.GCPMachine.Status.ReservedExternalAddress = v
+	}
+}
+
 // ANCHOR_END: MachineSetter
 
 // ANCHOR: MachineInstanceSpec
 
 // InstanceImageSpec returns compute instance image attched-disk spec.
-func (m *MachineScope) InstanceImageSpec() *compute.AttachedDisk {
-	image := "capi-ubuntu-1804-k8s-" + strings.ReplaceAll(semver.MajorMinor(*m.Machine.Spec.Version), ".", "-")
-	sourceImage := path.Join("projects", m.ClusterGetter.Project(), "global", "images", "family", image)
-	if m.GCPMachine.Spec.Image != nil {
+func (m *MachineScope) InstanceImageSpec() (*compute.AttachedDisk, error) {
+	var sourceImage string
+	switch {
+	case m.GCPMachine.Spec.Image != nil:
 		sourceImage = *m.GCPMachine.Spec.Image
-	} else if m.GCPMachine.Spec.ImageFamily != nil {
+	case m.GCPMachine.Spec.ImageFamily != nil:
 		sourceImage = *m.GCPMachine.Spec.ImageFamily
-	}
-
-	diskType := infrav1.PdStandardDiskType
-	if t := m.GCPMachine.Spec.RootDeviceType; t != nil {
-		diskType = *t
+	case m.GCPMachine.Spec.ImageLookup != nil:
+		if m.GCPMachine.Status.Image == nil {
+			return nil, errors.New("image lookup of the GCPMachine hasn't been resolved")
+		}
+		sourceImage = *m.GCPMachine.Status.Image
+	default:
+		image, err := m.defaultImageFamily()
+		if err != nil {
+			return nil, err
+		}
+		sourceImage = image
 	}
 
 	return &compute.AttachedDisk{
@@ -222,33 +545,74 @@ func (m *MachineScope) InstanceImageSpec() *compute.AttachedDisk {
 		Boot:       true,
 		InitializeParams: &compute.AttachedDiskInitializeParams{
 			DiskSizeGb:  m.GCPMachine.Spec.RootDeviceSize,
-			DiskType:    path.Join("zones", m.Zone(), "diskTypes", string(diskType)),
+			DiskType:    path.Join("zones", m.Zone(), "diskTypes", string(*m.GCPMachine.Spec.RootDeviceType)),
+			Labels:      m.instanceLabels(),
 			SourceImage: sourceImage,
 		},
+	}, nil
+}
+
+// defaultImageFamily renders the image family template of the GCPMachine.
+func (m *MachineScope) defaultImageFamily() (string, error) {
+	tmpl := infrav1.DefaultImageFamilyTemplate
+	if m.GCPMachine.Spec.ImageFamilyTemplate != nil {
+		tmpl = *m.GCPMachine.Spec.ImageFamilyTemplate
 	}
+
+	if m.Machine.Spec.Version == nil {
+		return "", errors.New("failed to select an image: Machine has no Kubernetes version, set image, imageFamily or imageLookup")
+	}
+
+	image, err := infrav1.RenderImageTemplate(tmpl, m.ClusterGetter.Project(), infrav1.DefaultImageLookupBaseOS, *m.Machine.Spec.Version)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render image family template")
+	}
+
+	return image, nil
 }
 
 // InstanceAdditionalDiskSpec returns compute instance additional attched-disk spec.
 func (m *MachineScope) InstanceAdditionalDiskSpec() []*compute.AttachedDisk {
 	additionalDisks := make([]*compute.AttachedDisk, 0, len(m.GCPMachine.Spec.AdditionalDisks))
 	for _, disk := range m.GCPMachine.Spec.AdditionalDisks {
+		diskType := infrav1.PdStandardDiskType
+		if disk.DeviceType != nil {
+			diskType = *disk.DeviceType
+		}
+
+		if diskType == infrav1.LocalSsdDiskType {
+			for i := int32(0); i < pointer.Int32Deref(disk.Count, 1); i++ {
+				additionalDisks = append(additionalDisks, &compute.AttachedDisk{
+					AutoDelete: true,
+					Type:       "SCRATCH", // Default is PERSISTENT.
+					InitializeParams: &compute.AttachedDiskInitializeParams{
+						// Local SSDs always have a size of 375GB.
+						DiskSizeGb: 375,
+						DiskType:   path.Join("zones", m.Zone(), "diskTypes", string(diskType)),
+					},
+					// For local SSDs set interface to NVME (instead of default SCSI) which is faster.
+					// Most OS images would work with both NVME and SCSI disks but some may work
+					// considerably faster with NVME.
+					// https://cloud.google.com/compute/docs/disks/local-ssd#choose_an_interface
+					Interface: "NVME",
+				})
+			}
+			continue
+		}
+
 		additionalDisk := &compute.AttachedDisk{
-			AutoDelete: true,
+			AutoDelete: pointer.BoolDeref(disk.AutoDelete, true),
 			InitializeParams: &compute.AttachedDiskInitializeParams{
-				DiskSizeGb: pointer.Int64PtrDerefOr(disk.Size, 30),
-				DiskType:   path.Join("zones", m.Zone(), "diskTypes", string(*disk.DeviceType)),
+				DiskName:         pointer.StringDeref(disk.Name, ""),
+				DiskSizeGb:       pointer.Int64PtrDerefOr(disk.Size, 30),
+				DiskType:         path.Join("zones", m.Zone(), "diskTypes", string(diskType)),
+				Labels:           m.instanceLabels().AddLabels(disk.Labels),
+				ProvisionedIops:  pointer.Int64Deref(disk.ProvisionedIOPS, 0),
+				ResourcePolicies: disk.ResourcePolicies,
+				SourceImage:      pointer.StringDeref(disk.SourceImage, ""),
+				SourceSnapshot:   pointer.StringDeref(disk.SourceSnapshot, ""),
 			},
 		}
-		if additionalDisk.InitializeParams.DiskType == string(infrav1.LocalSsdDiskType) {
-			additionalDisk.Type = "SCRATCH" // Default is PERSISTENT.
-			// Override the Disk size
-			additionalDisk.InitializeParams.DiskSizeGb = 375
-			// For local SSDs set interface to NVME (instead of default SCSI) which is faster.
-			// Most OS images would work with both NVME and SCSI disks but some may work
-			// considerably faster with NVME.
-			// https://cloud.google.com/compute/docs/disks/local-ssd#choose_an_interface
-			additionalDisk.Interface = "NVME"
-		}
 		additionalDisks = append(additionalDisks, additionalDisk)
 	}
 
@@ -277,21 +641,148 @@ func (m *MachineScope) InstanceNetworkInterfaceSpec() *compute.NetworkInterface
 	return networkInterface
 }
 
-// InstanceServiceAccountsSpec returns service-account spec.
-func (m *MachineScope) InstanceServiceAccountsSpec() *compute.ServiceAccount {
-	serviceAccount := &compute.ServiceAccount{
-		Email: "default",
-		Scopes: []string{
-			compute.CloudPlatformScope,
-		},
+// InstanceNetworkInterfacesSpec returns the network interfaces of the instance,
+// defaulting to the interface returned by InstanceNetworkInterfaceSpec.
+func (m *MachineScope) InstanceNetworkInterfacesSpec() []*compute.NetworkInterface {
+	networkInterfaces := m.instanceNetworkInterfaces()
+
+	// Bind the reserved static addresses to the primary network interface.
+	primary := networkInterfaces[0]
+	if address := m.GCPMachine.Status.ReservedInternalAddress; address != nil {
+		primary.NetworkIP = *address
+	}
+	if address := m.GCPMachine.Status.ReservedExternalAddress; address != nil {
+		if len(primary.AccessConfigs) == 0 {
+			primary.AccessConfigs = []*compute.AccessConfig{
+				{
+					Type: "ONE_TO_ONE_NAT",
+					Name: "External NAT",
+				},
+			}
+		}
+		primary.AccessConfigs[0].NatIP = *address
 	}
 
-	if m.GCPMachine.Spec.ServiceAccount != nil {
-		serviceAccount.Email = m.GCPMachine.Spec.ServiceAccount.Email
-		serviceAccount.Scopes = m.GCPMachine.Spec.ServiceAccount.Scopes
+	for _, networkInterface := range networkInterfaces {
+		for _, accessConfig := range networkInterface.AccessConfigs {
+			accessConfig.NetworkTier = computeNetworkTier(m.NetworkTier())
+		}
 	}
 
-	return serviceAccount
+	return networkInterfaces
+}
+
+func (m *MachineScope) instanceNetworkInterfaces() []*compute.NetworkInterface {
+	if len(m.GCPMachine.Spec.NetworkInterfaces) == 0 {
+		return []*compute.NetworkInterface{m.InstanceNetworkInterfaceSpec()}
+	}
+
+	networkInterfaces := make([]*compute.NetworkInterface, 0, len(m.GCPMachine.Spec.NetworkInterfaces))
+	for _, nic := range m.GCPMachine.Spec.NetworkInterfaces {
+		network := m.ClusterGetter.NetworkName()
+		if nic.Network != nil {
+			network = *nic.Network
+		}
+
+		networkInterface := &compute.NetworkInterface{
+			Network: path.Join("projects", m.ClusterGetter.Project(), "global", "networks", network),
+		}
+
+		if nic.Subnet != nil {
+			networkInterface.Subnetwork = path.Join("regions", m.ClusterGetter.Region(), "subnetworks", *nic.Subnet)
+		}
+
+		if nic.NetworkIP != nil {
+			networkInterface.NetworkIP = *nic.NetworkIP
+		}
+
+		for _, r := range nic.AliasIPRanges {
+			aliasIPRange := &compute.AliasIpRange{
+				IpCidrRange: r.IPCidrRange,
+			}
+			if r.SubnetworkRangeName != nil {
+				aliasIPRange.SubnetworkRangeName = *r.SubnetworkRangeName
+			}
+
+			networkInterface.AliasIpRanges = append(networkInterface.AliasIpRanges, aliasIPRange)
+		}
+
+		if nic.PublicIP != nil && *nic.PublicIP {
+			networkInterface.AccessConfigs = []*compute.AccessConfig{
+				{
+					Type: "ONE_TO_ONE_NAT",
+					Name: "External NAT",
+				},
+			}
+		}
+
+		if nic.NicType != nil {
+			networkInterface.NicType = string(*nic.NicType)
+		}
+
+		if nic.StackType != nil {
+			networkInterface.StackType = string(*nic.StackType)
+		}
+
+		// External IPv6 addresses are only available in the Premium tier.
+		if nic.IPv6PublicIP != nil && *nic.IPv6PublicIP {
+			networkInterface.Ipv6AccessConfigs = []*compute.AccessConfig{
+				{
+					Type:        "DIRECT_IPV6",
+					Name:        "External IPv6",
+					NetworkTier: computeNetworkTier(infrav1.NetworkTierPremium),
+				},
+			}
+		}
+
+		networkInterfaces = append(networkInterfaces, networkInterface)
+	}
+
+	return networkInterfaces
+}
+
+// InstanceAddressSpec returns the spec of the static address of the given type, INTERNAL or EXTERNAL,
+// reserved for the primary network interface of the instance.
+func (m *MachineScope) InstanceAddressSpec(addressType string) *compute.Address {
+	address := &compute.Address{
+		Name:        naming.Name(m.InstanceName(), strings.ToLower(addressType)),
+		Region:      m.ClusterGetter.Region(),
+		AddressType: addressType,
+		Description: infrav1.ClusterTagKey(m.ClusterGetter.ResourceNamePrefix()),
+	}
+
+	if reservation := m.AddressReservation(addressType); reservation != nil && reservation.Address != nil {
+		address.Address = *reservation.Address
+	}
+
+	if addressType == addressTypeExternal {
+		address.NetworkTier = computeNetworkTier(m.NetworkTier())
+	}
+
+	if addressType == addressTypeInternal {
+		if subnet := m.primarySubnet(); subnet != nil {
+			address.Subnetwork = path.Join("projects", m.ClusterGetter.Project(), "regions", m.ClusterGetter.Region(), "subnetworks", *subnet)
+		}
+	}
+
+	return address
+}
+
+// primarySubnet returns the subnetwork of the primary network interface, if any.
+func (m *MachineScope) primarySubnet() *string {
+	if len(m.GCPMachine.Spec.NetworkInterfaces) > 0 {
+		return m.GCPMachine.Spec.NetworkInterfaces[0].Subnet
+	}
+
+	return m.GCPMachine.Spec.Subnet
+}
+
+// InstanceServiceAccountsSpec returns service-account spec.
+func (m *MachineScope) InstanceServiceAccountsSpec() *compute.ServiceAccount {
+	return &compute.ServiceAccount{
+		Email:  m.GCPMachine.Spec.ServiceAccount.Email,
+		Scopes: m.GCPMachine.Spec.ServiceAccount.Scopes,
+	}
 }
 
 // InstanceAdditionalMetadataSpec returns additional metadata spec.
@@ -307,60 +798,233 @@ func (m *MachineScope) InstanceAdditionalMetadataSpec() *compute.Metadata {
 	return metadata
 }
 
+// InstanceBootstrapMetadataSpec returns the metadata item used to bootstrap the instance.
+func (m *MachineScope) InstanceBootstrapMetadataSpec(bootstrapData, format string) (*compute.MetadataItems, error) {
+	switch m.BootstrapDataStorage() {
+	case infrav1.BootstrapDataStorageSecretManager:
+		if format == cloud.BootstrapDataFormatIgnition {
+			return nil, errors.New("ignition bootstrap data can't be stored in Secret Manager, use GCS instead")
+		}
+		url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/projects/%s/secrets/%s/versions/latest:access", m.ClusterGetter.Project(), m.BootstrapDataSecretName())
+		bootstrapData = fmt.Sprintf(bootstrapDataFetchScript, url, secretManagerPayloadDecoder)
+	case infrav1.BootstrapDataStorageGCS:
+		if format == cloud.BootstrapDataFormatIgnition {
+			ignition, err := ignitionReplaceConfig(bootstrapData, fmt.Sprintf("gs://%s/%s", m.BootstrapDataBucket(), m.BootstrapDataObjectName()))
+			if err != nil {
+				return nil, err
+			}
+			bootstrapData = ignition
+			break
+		}
+		url := fmt.Sprintf("https://storage.googleapis.com/storage/v1/b/%s/o/%s?alt=media", m.BootstrapDataBucket(), neturl.PathEscape(m.BootstrapDataObjectName()))
+		bootstrapData = fmt.Sprintf(bootstrapDataFetchScript, url, "cat")
+	}
+
+	// Both cloud-init and Ignition read their configuration from the user-data key on GCE.
+	return &compute.MetadataItems{
+		Key:   "user-data",
+		Value: pointer.StringPtr(bootstrapData),
+	}, nil
+}
+
+// InstanceNodeAffinitiesSpec returns the sole-tenant node affinities spec.
+func (m *MachineScope) InstanceNodeAffinitiesSpec() []*compute.SchedulingNodeAffinity {
+	if len(m.GCPMachine.Spec.NodeAffinities) == 0 {
+		return nil
+	}
+
+	affinities := make([]*compute.SchedulingNodeAffinity, 0, len(m.GCPMachine.Spec.NodeAffinities))
+	for _, affinity := range m.GCPMachine.Spec.NodeAffinities {
+		key, values := affinity.Key, affinity.Values
+		if affinity.NodeGroup != nil {
+			key, values = nodeGroupAffinityKey, []string{*affinity.NodeGroup}
+		}
+
+		operator := "IN"
+		if affinity.Operator == infrav1.NodeAffinityOperatorNotIn {
+			operator = "NOT_IN"
+		}
+
+		affinities = append(affinities, &compute.SchedulingNodeAffinity{
+			Key:      key,
+			Operator: operator,
+			Values:   values,
+		})
+	}
+
+	return affinities
+}
+
+// InstanceReservationAffinitySpec returns the reservation affinity spec.
+func (m *MachineScope) InstanceReservationAffinitySpec() *compute.ReservationAffinity {
+	affinity := m.GCPMachine.Spec.ReservationAffinity
+	if affinity == nil {
+		return nil
+	}
+
+	switch affinity.Type {
+	case infrav1.ReservationAffinityTypeSpecific:
+		return &compute.ReservationAffinity{
+			ConsumeReservationType: "SPECIFIC_RESERVATION",
+			Key:                    reservationAffinityKey,
+			Values:                 affinity.Reservations,
+		}
+	case infrav1.ReservationAffinityTypeNone:
+		return &compute.ReservationAffinity{
+			ConsumeReservationType: "NO_RESERVATION",
+		}
+	default:
+		return &compute.ReservationAffinity{
+			ConsumeReservationType: "ANY_RESERVATION",
+		}
+	}
+}
+
+// InstanceAdvancedMachineFeaturesSpec returns the advanced machine features of the instance.
+func (m *MachineScope) InstanceAdvancedMachineFeaturesSpec() *compute.AdvancedMachineFeatures {
+	features := m.GCPMachine.Spec.AdvancedMachineFeatures
+	if features == nil {
+		return nil
+	}
+
+	spec := &compute.AdvancedMachineFeatures{
+		EnableNestedVirtualization: features.EnableNestedVirtualization,
+	}
+	if features.ThreadsPerCore != nil {
+		spec.ThreadsPerCore = *features.ThreadsPerCore
+	}
+
+	return spec
+}
+
+// InstanceGuestAcceleratorsSpec returns the accelerators attached to the instance.
+func (m *MachineScope) InstanceGuestAcceleratorsSpec() []*compute.AcceleratorConfig {
+	if len(m.GCPMachine.Spec.GuestAccelerators) == 0 {
+		return nil
+	}
+
+	accelerators := make([]*compute.AcceleratorConfig, 0, len(m.GCPMachine.Spec.GuestAccelerators))
+	for _, accelerator := range m.GCPMachine.Spec.GuestAccelerators {
+		accelerators = append(accelerators, &compute.AcceleratorConfig{
+			AcceleratorType:  infrav1.AcceleratorTypeURL(m.Zone(), accelerator.Type),
+			AcceleratorCount: accelerator.Count,
+		})
+	}
+
+	return accelerators
+}
+
+// instanceLabels returns the labels of the instance, also set on its disks.
+func (m *MachineScope) instanceLabels() infrav1.Labels {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: m.ClusterGetter.ResourceNamePrefix(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        pointer.StringPtr(m.Role()),
+		// TODO(vincepri): Check what needs to be added for the cloud provider label.
+		Additional: m.ClusterGetter.AdditionalLabels().AddLabels(m.GCPMachine.Spec.AdditionalLabels),
+	})
+}
+
 // InstanceSpec returns instance spec.
-func (m *MachineScope) InstanceSpec() *compute.Instance {
+func (m *MachineScope) InstanceSpec() (*compute.Instance, error) {
 	instance := &compute.Instance{
-		Name:         m.Name(),
+		Name:         m.InstanceName(),
 		Zone:         m.Zone(),
-		MachineType:  path.Join("zones", m.Zone(), "machineTypes", m.GCPMachine.Spec.InstanceType),
+		MachineType:  infrav1.MachineTypeURL(m.Zone(), m.GCPMachine.Spec.MachineType()),
 		CanIpForward: true,
 		Tags: &compute.Tags{
 			Items: append(
 				m.GCPMachine.Spec.AdditionalNetworkTags,
-				fmt.Sprintf("%s-%s", m.ClusterGetter.Name(), m.Role()),
-				m.ClusterGetter.Name(),
+				naming.Name(m.ClusterGetter.ResourceNamePrefix(), m.Role()),
+				m.ClusterGetter.ResourceNamePrefix(),
 			),
 		},
-		Labels: infrav1.Build(infrav1.BuildParams{
-			ClusterName: m.ClusterGetter.Name(),
-			Lifecycle:   infrav1.ResourceLifecycleOwned,
-			Role:        pointer.StringPtr(m.Role()),
-			// TODO(vincepri): Check what needs to be added for the cloud provider label.
-			Additional: m.ClusterGetter.AdditionalLabels().AddLabels(m.GCPMachine.Spec.AdditionalLabels),
-		}),
+		Labels: m.instanceLabels(),
 		Scheduling: &compute.Scheduling{
-			Preemptible: m.GCPMachine.Spec.Preemptible,
+			Preemptible:    m.GCPMachine.Spec.Preemptible,
+			NodeAffinities: m.InstanceNodeAffinitiesSpec(),
 		},
+		ReservationAffinity:     m.InstanceReservationAffinitySpec(),
+		AdvancedMachineFeatures: m.InstanceAdvancedMachineFeaturesSpec(),
+		GuestAccelerators:       m.InstanceGuestAcceleratorsSpec(),
+	}
+
+	// Instances with guest accelerators can't be live migrated.
+	if len(instance.GuestAccelerators) > 0 {
+		instance.Scheduling.OnHostMaintenance = "TERMINATE"
+	}
+
+	if platform := m.GCPMachine.Spec.MinCPUPlatform; platform != nil {
+		instance.MinCpuPlatform = *platform
+	}
+
+	if policy := m.GCPMachine.Spec.PlacementPolicy; policy != nil {
+		instance.ResourcePolicies = []string{
+			path.Join("projects", m.ClusterGetter.Project(), "regions", m.ClusterGetter.Region(), "resourcePolicies", placementPolicyName(m.ClusterGetter.ResourceNamePrefix(), *policy)),
+		}
+
+		// Instances of compact placement policies can't be live migrated.
+		if m.isCompactPlacementPolicy(*policy) {
+			instance.Scheduling.OnHostMaintenance = "TERMINATE"
+		}
 	}
 
-	instance.Disks = append(instance.Disks, m.InstanceImageSpec())
+	imageSpec, err := m.InstanceImageSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	instance.Disks = append(instance.Disks, imageSpec)
 	instance.Disks = append(instance.Disks, m.InstanceAdditionalDiskSpec()...)
 	instance.Metadata = m.InstanceAdditionalMetadataSpec()
 	instance.ServiceAccounts = append(instance.ServiceAccounts, m.InstanceServiceAccountsSpec())
-	instance.NetworkInterfaces = append(instance.NetworkInterfaces, m.InstanceNetworkInterfaceSpec())
-	return instance
+	instance.NetworkInterfaces = m.InstanceNetworkInterfacesSpec()
+	return instance, nil
+}
+
+// isCompactPlacementPolicy returns true if the named placement policy of the cluster has the compact collocation.
+func (m *MachineScope) isCompactPlacementPolicy(name string) bool {
+	for _, policy := range m.ClusterGetter.PlacementPolicies() {
+		if policy.Name == name {
+			return policy.Collocation == infrav1.PlacementPolicyCollocationCompact
+		}
+	}
+
+	return false
 }
 
 // ANCHOR_END: MachineInstanceSpec
 
 // GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
 func (m *MachineScope) GetBootstrapData() (string, error) {
+	value, _, err := m.GetBootstrapDataWithFormat()
+	return value, err
+}
+
+// GetBootstrapDataWithFormat returns the bootstrap data and its format from the secret in the Machine's
+// bootstrap.dataSecretName. The format defaults to cloud-config when the secret doesn't specify one.
+func (m *MachineScope) GetBootstrapDataWithFormat() (string, string, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+		return "", "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
 	}
 
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.client.Get(context.TODO(), key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for GCPMachine %s/%s", m.Namespace(), m.Name())
+		return "", "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for GCPMachine %s/%s", m.Namespace(), m.Name())
 	}
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return "", "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+
+	format := cloud.BootstrapDataFormatCloudConfig
+	if f, ok := secret.Data["format"]; ok && len(f) > 0 {
+		format = string(f)
 	}
 
-	return string(value), nil
+	return string(value), format, nil
 }
 
 // PatchObject persists the cluster configuration and status.
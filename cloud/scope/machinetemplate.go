@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+// MachineTemplateScopeParams defines the input parameters used to create a new MachineTemplateScope.
+type MachineTemplateScopeParams struct {
+	Client             client.Client
+	ClusterGetter      cloud.ClusterGetter
+	GCPMachineTemplate *infrav1.GCPMachineTemplate
+}
+
+// NewMachineTemplateScope creates a new MachineTemplateScope from the supplied parameters.
+// This is meant to be called for each reconcile iteration.
+func NewMachineTemplateScope(params MachineTemplateScopeParams) (*MachineTemplateScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("client is required when creating a MachineTemplateScope")
+	}
+	if params.GCPMachineTemplate == nil {
+		return nil, errors.New("gcp machine template is required when creating a MachineTemplateScope")
+	}
+
+	helper, err := patch.NewHelper(params.GCPMachineTemplate, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init patch helper")
+	}
+
+	// Templates stored before their defaults were materialized by the webhook can't be
+	// defaulted in place, as their spec is immutable: the defaults are applied to a copy.
+	machine := &infrav1.GCPMachine{Spec: *params.GCPMachineTemplate.Spec.Template.Spec.DeepCopy()}
+	machine.Default()
+
+	return &MachineTemplateScope{
+		client:             params.Client,
+		ClusterGetter:      params.ClusterGetter,
+		GCPMachineTemplate: params.GCPMachineTemplate,
+		machineSpec:        &machine.Spec,
+		patchHelper:        helper,
+	}, nil
+}
+
+// MachineTemplateScope defines a scope defined around a machine template and its cluster.
+type MachineTemplateScope struct {
+	client             client.Client
+	patchHelper        *patch.Helper
+	ClusterGetter      cloud.ClusterGetter
+	GCPMachineTemplate *infrav1.GCPMachineTemplate
+	// machineSpec is the defaulted spec of the machines created from the template.
+	machineSpec *infrav1.GCPMachineSpec
+}
+
+// ComputeClient returns initialized compute client.
+func (m *MachineTemplateScope) ComputeClient() *compute.Service {
+	return m.ClusterGetter.ComputeClient()
+}
+
+// Project returns the project of the machines created from the template.
+func (m *MachineTemplateScope) Project() string {
+	return m.ClusterGetter.Project()
+}
+
+// Zone returns the zone the machine type of the template is resolved in: the failure domain
+// of the template, or the first failure domain of the cluster.
+func (m *MachineTemplateScope) Zone() string {
+	if m.machineSpec.FailureDomain != nil {
+		return *m.machineSpec.FailureDomain
+	}
+
+	zones := make([]string, 0, len(m.ClusterGetter.FailureDomains()))
+	for zone := range m.ClusterGetter.FailureDomains() {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	if len(zones) == 0 {
+		return ""
+	}
+
+	return zones[0]
+}
+
+// MachineType returns the name of the machine type of the template.
+func (m *MachineTemplateScope) MachineType() string {
+	return m.machineSpec.MachineType()
+}
+
+// RootDeviceSize returns the size of the root volume in GB of the machines created from the template.
+func (m *MachineTemplateScope) RootDeviceSize() int64 {
+	return m.machineSpec.RootDeviceSize
+}
+
+// Capacity returns the resource capacity reported by the template.
+func (m *MachineTemplateScope) Capacity() corev1.ResourceList {
+	return m.GCPMachineTemplate.Status.Capacity
+}
+
+// SetCapacity sets the resource capacity reported by the template.
+func (m *MachineTemplateScope) SetCapacity(capacity corev1.ResourceList) {
+	m.GCPMachineTemplate.Status.Capacity = capacity
+}
+
+// PatchObject persists the machine template spec and status.
+func (m *MachineTemplateScope) PatchObject() error {
+	return m.patchHelper.Patch(context.TODO(), m.GCPMachineTemplate)
+}
+
+// Close closes the current scope persisting the machine template status.
+func (m *MachineTemplateScope) Close() error {
+	return m.PatchObject()
+}
+
+// CustomMachineType returns the custom machine type of the template, if any.
+func (m *MachineTemplateScope) CustomMachineType() *infrav1.CustomMachineType {
+	return m.machineSpec.CustomMachineType
+}
+
+// GuestAccelerators returns the accelerators attached to the machines created from the template.
+func (m *MachineTemplateScope) GuestAccelerators() []infrav1.GuestAccelerator {
+	return m.machineSpec.GuestAccelerators
+}
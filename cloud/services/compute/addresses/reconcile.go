@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addresses
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+// addressTypes are the types of the static addresses reserved for the instance.
+var addressTypes = []string{"INTERNAL", "EXTERNAL"}
+
+// Reconcile reconcile machine static addresses.
+func (s *Service) Reconcile(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	log.Info("Reconciling address resources")
+	for _, addressType := range addressTypes {
+		reservation := s.scope.AddressReservation(addressType)
+		if reservation == nil {
+			continue
+		}
+
+		addr, err := s.createOrGetAddress(ctx, addressType)
+		if err != nil {
+			return err
+		}
+
+		// A reserved address which isn't in use while the instance was created
+		// means the instance is being recreated.
+		if !reservation.Preserve && addr.Status == "RESERVED" && s.scope.GetProviderID() != "" {
+			log.V(2).Info("Releasing address of the deleted instance", "name", addr.Name)
+			if err := s.deleteAddress(ctx, addressType); err != nil {
+				return err
+			}
+
+			if addr, err = s.createOrGetAddress(ctx, addressType); err != nil {
+				return err
+			}
+		}
+
+		s.scope.SetReservedAddress(addressType, pointer.String(addr.Address))
+	}
+
+	return nil
+}
+
+// Delete delete machine static addresses.
+func (s *Service) Delete(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	log.Info("Deleting address resources")
+	for _, addressType := range addressTypes {
+		if s.scope.AddressReservation(addressType) == nil {
+			continue
+		}
+
+		if err := s.deleteAddress(ctx, addressType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) createOrGetAddress(ctx context.Context, addressType string) (*compute.Address, error) {
+	log := log.FromContext(ctx)
+	spec := s.scope.InstanceAddressSpec(addressType)
+	key := meta.RegionalKey(spec.Name, spec.Region)
+	log.V(2).Info("Looking for address", "name", spec.Name)
+	addr, err := s.addresses.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for address", "name", spec.Name)
+			return nil, err
+		}
+
+		log.V(2).Info("Creating an address", "name", spec.Name)
+		if err := s.addresses.Insert(ctx, key, spec); err != nil {
+			log.Error(err, "Error creating an address", "name", spec.Name)
+			return nil, err
+		}
+
+		addr, err = s.addresses.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addr, nil
+}
+
+func (s *Service) deleteAddress(ctx context.Context, addressType string) error {
+	log := log.FromContext(ctx)
+	spec := s.scope.InstanceAddressSpec(addressType)
+	log.V(2).Info("Deleting an address", "name", spec.Name)
+	if err := s.addresses.Delete(ctx, meta.RegionalKey(spec.Name, spec.Region)); err != nil && !gcperrors.IsNotFound(err) {
+		log.Error(err, "Error deleting an address", "name", spec.Name)
+		return err
+	}
+
+	s.scope.SetReservedAddress(addressType, nil)
+	return nil
+}
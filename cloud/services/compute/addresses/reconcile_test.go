@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addresses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+func newMachineScope(t *testing.T, gcpMachine *infrav1.GCPMachine) *scope.MachineScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fakec,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client: fakec,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+		},
+		GCPMachine:    gcpMachine,
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
+
+func newGCPMachine(preserve bool, providerID *string) *infrav1.GCPMachine {
+	return &infrav1.GCPMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-machine",
+			Namespace: "default",
+		},
+		Spec: infrav1.GCPMachineSpec{
+			Subnet:     pointer.String("nodes"),
+			ProviderID: providerID,
+			AddressReservations: &infrav1.AddressReservations{
+				Internal: &infrav1.AddressReservation{
+					Address:  pointer.String("10.0.0.10"),
+					Preserve: preserve,
+				},
+			},
+		},
+		Status: infrav1.GCPMachineStatus{
+			InstanceName: pointer.String("my-machine"),
+		},
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		gcpMachine  *infrav1.GCPMachine
+		objects     map[meta.Key]*cloud.MockAddressesObj
+		wantAddress string
+		wantStatus  string
+	}{
+		{
+			name:        "address does not exist (should reserve it)",
+			gcpMachine:  newGCPMachine(false, nil),
+			objects:     map[meta.Key]*cloud.MockAddressesObj{},
+			wantAddress: "10.0.0.10",
+		},
+		{
+			name:       "address of a deleted instance (should reserve a new one)",
+			gcpMachine: newGCPMachine(false, pointer.String("gce://my-proj/us-central1-c/my-machine")),
+			objects: map[meta.Key]*cloud.MockAddressesObj{
+				*meta.RegionalKey("my-machine-internal", "us-central1"): {Obj: &compute.Address{
+					Name:    "my-machine-internal",
+					Address: "10.0.0.9",
+					Status:  "RESERVED",
+				}},
+			},
+			wantAddress: "10.0.0.10",
+		},
+		{
+			name:       "preserved address of a deleted instance (should keep it)",
+			gcpMachine: newGCPMachine(true, pointer.String("gce://my-proj/us-central1-c/my-machine")),
+			objects: map[meta.Key]*cloud.MockAddressesObj{
+				*meta.RegionalKey("my-machine-internal", "us-central1"): {Obj: &compute.Address{
+					Name:    "my-machine-internal",
+					Address: "10.0.0.9",
+					Status:  "RESERVED",
+				}},
+			},
+			wantAddress: "10.0.0.9",
+			wantStatus:  "RESERVED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			machineScope := newMachineScope(t, tt.gcpMachine)
+			mock := &cloud.MockAddresses{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "my-proj"},
+				Objects:       tt.objects,
+			}
+			s := New(machineScope)
+			s.addresses = mock
+
+			if err := s.Reconcile(ctx); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			got := pointer.StringDeref(machineScope.GCPMachine.Status.ReservedInternalAddress, "")
+			if got != tt.wantAddress {
+				t.Errorf("Service.Reconcile() reserved address = %v, want %v", got, tt.wantAddress)
+			}
+
+			addr, err := mock.Get(ctx, meta.RegionalKey("my-machine-internal", "us-central1"))
+			if err != nil {
+				t.Fatalf("MockAddresses.Get() error = %v", err)
+			}
+			if addr.Status != tt.wantStatus {
+				t.Errorf("Service.Reconcile() address status = %v, want %v", addr.Status, tt.wantStatus)
+			}
+			if want := "projects/my-proj/regions/us-central1/subnetworks/nodes"; addr.Subnetwork != want && tt.wantStatus == "" {
+				t.Errorf("Service.Reconcile() address subnetwork = %v, want %v", addr.Subnetwork, want)
+			}
+
+			if err := s.Delete(ctx); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+			if len(mock.Objects) != 0 {
+				t.Errorf("Service.Delete() left addresses %v", mock.Objects)
+			}
+			if machineScope.GCPMachine.Status.ReservedInternalAddress != nil {
+				t.Errorf("Service.Delete() did not clear the reserved address")
+			}
+		})
+	}
+}
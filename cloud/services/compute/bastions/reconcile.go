@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastions
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/naming"
+)
+
+// Reconcile reconciles the cluster bastion host. A bastion host removed from the spec is deleted.
+func (s *Service) Reconcile(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	if s.scope.Bastion() == nil {
+		return s.Delete(ctx)
+	}
+
+	log.Info("Reconciling bastion resources")
+	for _, spec := range s.scope.BastionFirewallRulesSpec() {
+		if err := s.createOrUpdateFirewall(ctx, spec); err != nil {
+			return err
+		}
+	}
+
+	zone := s.scope.BastionZone()
+	if zone == "" {
+		return errors.New("failed to select the zone of the bastion host: the cluster has no failure domains")
+	}
+
+	// Record the zone before creating the instance, so that it's found on deletion.
+	status := &infrav1.BastionStatus{Zone: zone}
+	if current := s.scope.BastionStatus(); current != nil {
+		status = current.DeepCopy()
+	}
+	s.scope.SetBastionStatus(status)
+
+	spec := s.scope.BastionInstanceSpec()
+	key := meta.ZonalKey(spec.Name, zone)
+	log.V(2).Info("Looking for bastion instance", "name", spec.Name, "zone", zone)
+	instance, err := s.instances.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for bastion instance", "name", spec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating bastion instance", "name", spec.Name, "zone", zone)
+		if err := s.instances.Insert(ctx, key, spec); err != nil {
+			log.Error(err, "Error creating bastion instance", "name", spec.Name)
+			return err
+		}
+
+		instance, err = s.instances.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	status.SelfLink = &instance.SelfLink
+	status.Addresses = instanceAddresses(instance)
+	return nil
+}
+
+// Delete deletes the cluster bastion host and its firewall rules.
+func (s *Service) Delete(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	if s.scope.Bastion() == nil && s.scope.BastionStatus() == nil {
+		return nil
+	}
+
+	log.Info("Deleting bastion resources")
+	if zone := s.scope.BastionZone(); zone != "" {
+		name := naming.Name(s.scope.ResourceNamePrefix(), infrav1.BastionRoleTagValue)
+		log.V(2).Info("Deleting bastion instance", "name", name, "zone", zone)
+		if err := s.instances.Delete(ctx, meta.ZonalKey(name, zone)); err != nil && !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error deleting bastion instance", "name", name)
+			return err
+		}
+	}
+
+	for _, spec := range s.scope.BastionFirewallRulesSpec() {
+		log.V(2).Info("Deleting firewall", "name", spec.Name)
+		if err := s.firewalls.Delete(ctx, meta.GlobalKey(spec.Name)); err != nil && !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error deleting firewall", "name", spec.Name)
+			return err
+		}
+	}
+
+	s.scope.SetBastionStatus(nil)
+	return nil
+}
+
+// createOrUpdateFirewall creates a firewall rule, or updates its source ranges when they changed.
+func (s *Service) createOrUpdateFirewall(ctx context.Context, spec *compute.Firewall) error {
+	log := log.FromContext(ctx)
+	key := meta.GlobalKey(spec.Name)
+	log.V(2).Info("Looking for firewall", "name", spec.Name)
+	firewall, err := s.firewalls.Get(ctx, key)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for firewall", "name", spec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating firewall", "name", spec.Name)
+		return s.firewalls.Insert(ctx, key, spec)
+	}
+
+	if reflect.DeepEqual(firewall.SourceRanges, spec.SourceRanges) {
+		return nil
+	}
+
+	log.V(2).Info("Updating firewall source ranges", "name", spec.Name)
+	firewall.SourceRanges = spec.SourceRanges
+	return s.firewalls.Update(ctx, key, firewall)
+}
+
+// instanceAddresses returns the internal and external addresses of an instance.
+func instanceAddresses(instance *compute.Instance) []corev1.NodeAddress {
+	addresses := make([]corev1.NodeAddress, 0, len(instance.NetworkInterfaces))
+	for _, iface := range instance.NetworkInterfaces {
+		addresses = append(addresses, corev1.NodeAddress{
+			Type:    corev1.NodeInternalIP,
+			Address: iface.NetworkIP,
+		})
+
+		for _, ac := range iface.AccessConfigs {
+			if ac.NatIP != "" {
+				addresses = append(addresses, corev1.NodeAddress{
+					Type:    corev1.NodeExternalIP,
+					Address: ac.NatIP,
+				})
+			}
+		}
+	}
+
+	return addresses
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/mock"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+func newClusterScope(t *testing.T, bastion *infrav1.BastionSpec) *scope.ClusterScope {
+	t.Helper()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Bastion: bastion,
+			},
+			Status: infrav1.GCPClusterStatus{
+				FailureDomains: clusterv1.FailureDomains{
+					"us-central1-b": clusterv1.FailureDomainSpec{ControlPlane: true},
+					"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+				},
+				Network: infrav1.Network{
+					SelfLink: pointer.String("https://www.googleapis.com/compute/v1/projects/my-proj/global/networks/my-cluster"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return clusterScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name             string
+		bastion          *infrav1.BastionSpec
+		wantSourceRanges []string
+		wantPublicIP     bool
+	}{
+		{
+			name:             "SSH access mode defaults",
+			bastion:          &infrav1.BastionSpec{},
+			wantSourceRanges: []string{"0.0.0.0/0"},
+			wantPublicIP:     true,
+		},
+		{
+			name: "SSH access mode with allowed CIDR blocks",
+			bastion: &infrav1.BastionSpec{
+				AllowedCIDRBlocks: []string{"203.0.113.0/24"},
+				PublicIP:          pointer.Bool(false),
+			},
+			wantSourceRanges: []string{"203.0.113.0/24"},
+		},
+		{
+			name: "IAP access mode",
+			bastion: &infrav1.BastionSpec{
+				AccessMode: infrav1.BastionAccessModeIAP,
+			},
+			wantSourceRanges: []string{"35.235.240.0/20"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterScope := newClusterScope(t, tt.bastion)
+			gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+			s := &Service{scope: clusterScope, instances: gce.Instances(), firewalls: gce.Firewalls()}
+
+			ctx := context.TODO()
+			if err := s.Reconcile(ctx); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			instance, err := gce.Instances().Get(ctx, meta.ZonalKey("my-cluster-bastion", "us-central1-a"))
+			if err != nil {
+				t.Fatalf("Instances.Get() error = %v", err)
+			}
+			if got := len(instance.NetworkInterfaces[0].AccessConfigs) > 0; got != tt.wantPublicIP {
+				t.Errorf("Service.Reconcile() public IP = %v, want %v", got, tt.wantPublicIP)
+			}
+
+			firewall, err := gce.Firewalls().Get(ctx, meta.GlobalKey("allow-my-cluster-bastion"))
+			if err != nil {
+				t.Fatalf("Firewalls.Get() error = %v", err)
+			}
+			if !reflect.DeepEqual(firewall.SourceRanges, tt.wantSourceRanges) {
+				t.Errorf("Service.Reconcile() source ranges = %v, want %v", firewall.SourceRanges, tt.wantSourceRanges)
+			}
+
+			status := clusterScope.BastionStatus()
+			if status == nil || status.Zone != "us-central1-a" || pointer.StringDeref(status.SelfLink, "") != instance.SelfLink {
+				t.Errorf("Service.Reconcile() status = %+v, want zone us-central1-a and self link %q", status, instance.SelfLink)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_UpdatesAllowedCIDRBlocks(t *testing.T) {
+	clusterScope := newClusterScope(t, &infrav1.BastionSpec{AllowedCIDRBlocks: []string{"203.0.113.0/24"}})
+	gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	gce.MockFirewalls.UpdateHook = mock.UpdateFirewallHook
+	s := &Service{scope: clusterScope, instances: gce.Instances(), firewalls: gce.Firewalls()}
+
+	ctx := context.TODO()
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	clusterScope.GCPCluster.Spec.Bastion.AllowedCIDRBlocks = []string{"198.51.100.0/24"}
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	firewall, err := gce.Firewalls().Get(ctx, meta.GlobalKey("allow-my-cluster-bastion"))
+	if err != nil {
+		t.Fatalf("Firewalls.Get() error = %v", err)
+	}
+	if want := []string{"198.51.100.0/24"}; !reflect.DeepEqual(firewall.SourceRanges, want) {
+		t.Errorf("Service.Reconcile() source ranges = %v, want %v", firewall.SourceRanges, want)
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	clusterScope := newClusterScope(t, &infrav1.BastionSpec{})
+	gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	s := &Service{scope: clusterScope, instances: gce.Instances(), firewalls: gce.Firewalls()}
+
+	ctx := context.TODO()
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	// Removing the bastion from the spec tears it down.
+	clusterScope.GCPCluster.Spec.Bastion = nil
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	if _, err := gce.Instances().Get(ctx, meta.ZonalKey("my-cluster-bastion", "us-central1-a")); err == nil {
+		t.Errorf("Service.Reconcile() did not delete the bastion instance")
+	}
+	for _, name := range []string{"allow-my-cluster-bastion", "allow-my-cluster-bastion-nodes"} {
+		if _, err := gce.Firewalls().Get(ctx, meta.GlobalKey(name)); err == nil {
+			t.Errorf("Service.Reconcile() did not delete the firewall %q", name)
+		}
+	}
+	if status := clusterScope.BastionStatus(); status != nil {
+		t.Errorf("Service.Reconcile() status = %+v, want nil", status)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bastions
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type instancesInterface interface {
+	Get(ctx context.Context, key *meta.Key) (*compute.Instance, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.Instance) error
+	Delete(ctx context.Context, key *meta.Key) error
+}
+
+type firewallsInterface interface {
+	Get(ctx context.Context, key *meta.Key) (*compute.Firewall, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.Firewall) error
+	Update(ctx context.Context, key *meta.Key, obj *compute.Firewall) error
+	Delete(ctx context.Context, key *meta.Key) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Cluster
+	Bastion() *infrav1.BastionSpec
+	BastionStatus() *infrav1.BastionStatus
+	BastionZone() string
+	BastionInstanceSpec() *compute.Instance
+	BastionFirewallRulesSpec() []*compute.Firewall
+	SetBastionStatus(status *infrav1.BastionStatus)
+}
+
+// Service implements bastions reconciler.
+type Service struct {
+	scope     Scope
+	instances instancesInterface
+	firewalls firewallsInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:     scope,
+		instances: scope.Cloud().Instances(),
+		firewalls: scope.Cloud().Firewalls(),
+	}
+}
@@ -18,6 +18,7 @@ package firewalls
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 
@@ -33,7 +34,8 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	for _, spec := range s.scope.FirewallRulesSpec() {
 		log.V(2).Info("Looking firewall", "name", spec.Name)
 		firewallKey := meta.GlobalKey(spec.Name)
-		if _, err := s.firewalls.Get(ctx, firewallKey); err != nil {
+		firewall, err := s.firewalls.Get(ctx, firewallKey)
+		if err != nil {
 			if !gcperrors.IsNotFound(err) {
 				return err
 			}
@@ -42,6 +44,17 @@ func (s *Service) Reconcile(ctx context.Context) error {
 			if err := s.firewalls.Insert(ctx, firewallKey, spec); err != nil {
 				return err
 			}
+
+			continue
+		}
+
+		// The source ranges of the API server rule follow the control plane access.
+		if !reflect.DeepEqual(firewall.SourceRanges, spec.SourceRanges) {
+			log.V(2).Info("Updating firewall source ranges", "name", spec.Name)
+			firewall.SourceRanges = spec.SourceRanges
+			if err := s.firewalls.Update(ctx, firewallKey, firewall); err != nil {
+				return err
+			}
 		}
 	}
 
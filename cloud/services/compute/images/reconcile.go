@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+// Reconcile resolves the image lookup of the machine.
+func (s *Service) Reconcile(ctx context.Context) error {
+	lookup := s.scope.ImageLookup()
+	if lookup == nil || s.scope.GetImage() != nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Reconciling image lookup")
+	image, err := s.scope.RolloutImage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if image != nil {
+		log.V(2).Info("Using the image of the MachineDeployment rollout", "image", *image)
+		s.scope.SetImage(*image)
+		return nil
+	}
+
+	project := s.scope.Project()
+	if lookup.Project != nil {
+		project = *lookup.Project
+	}
+
+	filter, nameFilter, err := s.filters(lookup)
+	if err != nil {
+		return err
+	}
+
+	log.V(2).Info("Looking for images", "project", project, "filter", filter, "nameFilter", nameFilter)
+	images, err := s.images.List(ctx, project, filter)
+	if err != nil {
+		log.Error(err, "Error looking for images", "project", project, "filter", filter)
+		return err
+	}
+
+	newest := newestImage(images, nameFilter)
+	if newest == nil {
+		return errors.Errorf("failed to find an image in project %q matching filter %q and name filter %q", project, filter, nameFilter)
+	}
+
+	log.V(2).Info("Resolved image lookup", "image", newest.SelfLink)
+	s.scope.SetImage(fmt.Sprintf("projects/%s/global/images/%s", project, newest.Name))
+	return nil
+}
+
+// Delete is a no-op as image lookups don't own any resource.
+func (s *Service) Delete(ctx context.Context) error {
+	return nil
+}
+
+// filters returns the Images API filter and the image name filter of the image lookup.
+func (s *Service) filters(lookup *infrav1.ImageLookup) (string, *regexp.Regexp, error) {
+	baseOS := infrav1.DefaultImageLookupBaseOS
+	if lookup.BaseOS != nil {
+		baseOS = *lookup.BaseOS
+	}
+
+	familyTemplate := lookup.FamilyTemplate
+	if familyTemplate == nil && lookup.NameFilter == nil && len(lookup.Labels) == 0 {
+		familyTemplate = pointer.StringPtr(infrav1.DefaultImageLookupFamilyTemplate)
+	}
+
+	nameFilterTemplate := lookup.NameFilter
+	if nameFilterTemplate == nil && s.scope.Version() != "" {
+		nameFilterTemplate = pointer.StringPtr(infrav1.DefaultImageLookupNameFilterTemplate)
+	}
+
+	var conditions []string
+	if familyTemplate != nil {
+		family, err := infrav1.RenderImageTemplate(*familyTemplate, s.scope.Project(), baseOS, s.scope.Version())
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to render image lookup family template")
+		}
+		conditions = append(conditions, fmt.Sprintf("(family = %q)", family))
+	}
+
+	keys := make([]string, 0, len(lookup.Labels))
+	for k := range lookup.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		conditions = append(conditions, fmt.Sprintf("(labels.%s = %q)", k, lookup.Labels[k]))
+	}
+
+	var nameFilter *regexp.Regexp
+	if nameFilterTemplate != nil {
+		expr, err := infrav1.RenderImageTemplate(*nameFilterTemplate, s.scope.Project(), baseOS, s.scope.Version())
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to render image lookup name filter")
+		}
+
+		nameFilter, err = regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to compile image lookup name filter")
+		}
+	}
+
+	return strings.Join(conditions, " AND "), nameFilter, nil
+}
+
+// newestImage returns the most recently created image that isn't deprecated and matches the name filter.
+func newestImage(images []*compute.Image, nameFilter *regexp.Regexp) *compute.Image {
+	var newest *compute.Image
+	var newestTime time.Time
+	for _, image := range images {
+		if image.Deprecated != nil && image.Deprecated.State != "" && image.Deprecated.State != "ACTIVE" {
+			continue
+		}
+
+		if nameFilter != nil && !nameFilter.MatchString(image.Name) {
+			continue
+		}
+
+		created, err := time.Parse(time.RFC3339, image.CreationTimestamp)
+		if err != nil {
+			continue
+		}
+
+		if newest == nil || created.After(newestTime) {
+			newest, newestTime = image, created
+		}
+	}
+
+	return newest
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+type fakeImages struct {
+	project string
+	filter  string
+	images  []*compute.Image
+}
+
+func (f *fakeImages) List(_ context.Context, project, filter string) ([]*compute.Image, error) {
+	f.project, f.filter = project, filter
+	return f.images, nil
+}
+
+var rolloutLabels = map[string]string{
+	clusterv1.ClusterLabelName:           "my-cluster",
+	clusterv1.MachineDeploymentLabelName: "my-md",
+	"machine-template-hash":              "1234",
+}
+
+func newMachineScope(t *testing.T, lookup *infrav1.ImageLookup, objs ...client.Object) *scope.MachineScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fakec,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client: fakec,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+				Labels:    rolloutLabels,
+			},
+			Spec: clusterv1.MachineSpec{
+				Version: pointer.String("v1.21.2"),
+			},
+		},
+		GCPMachine: &infrav1.GCPMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPMachineSpec{
+				ImageLookup: lookup,
+			},
+		},
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	images := []*compute.Image{
+		{Name: "capi-ubuntu-1804-k8s-v1-21-2-1620000000", CreationTimestamp: "2021-05-03T00:00:00.000-07:00"},
+		{Name: "capi-ubuntu-1804-k8s-v1-21-2-1630000000", CreationTimestamp: "2021-08-26T00:00:00.000-07:00"},
+		{Name: "capi-ubuntu-1804-k8s-v1-21-2-1640000000", CreationTimestamp: "2021-12-20T00:00:00.000-07:00", Deprecated: &compute.DeprecationStatus{State: "DEPRECATED"}},
+		{Name: "capi-ubuntu-1804-k8s-v1-21-20-1650000000", CreationTimestamp: "2022-04-15T00:00:00.000-07:00"},
+	}
+
+	otherMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-other-machine",
+			Namespace: "default",
+			Labels:    rolloutLabels,
+		},
+		Spec: clusterv1.MachineSpec{
+			InfrastructureRef: corev1.ObjectReference{Name: "my-other-machine"},
+		},
+	}
+	otherGCPMachine := &infrav1.GCPMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-other-machine",
+			Namespace: "default",
+		},
+		Status: infrav1.GCPMachineStatus{
+			Image: pointer.String("projects/my-proj/global/images/pinned"),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		lookup     *infrav1.ImageLookup
+		objs       []client.Object
+		wantFilter string
+		want       *string
+	}{
+		{
+			name: "no image lookup (should not resolve an image)",
+		},
+		{
+			name:       "default image lookup (should resolve the newest image of the kubernetes version)",
+			lookup:     &infrav1.ImageLookup{},
+			wantFilter: `(family = "capi-ubuntu-1804-k8s-v1-21")`,
+			want:       pointer.String("projects/my-proj/global/images/capi-ubuntu-1804-k8s-v1-21-2-1630000000"),
+		},
+		{
+			name: "image lookup by labels in another project",
+			lookup: &infrav1.ImageLookup{
+				Project: pointer.String("images-proj"),
+				Labels:  map[string]string{"os": "ubuntu", "k8s": "v1-21"},
+			},
+			wantFilter: `(labels.k8s = "v1-21") AND (labels.os = "ubuntu")`,
+			want:       pointer.String("projects/images-proj/global/images/capi-ubuntu-1804-k8s-v1-21-2-1630000000"),
+		},
+		{
+			name:   "machine of a rollout with a resolved image (should use the same image)",
+			lookup: &infrav1.ImageLookup{},
+			objs:   []client.Object{otherMachine, otherGCPMachine},
+			want:   pointer.String("projects/my-proj/global/images/pinned"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machineScope := newMachineScope(t, tt.lookup, tt.objs...)
+			fakeImages := &fakeImages{images: images}
+			s := &Service{
+				scope:  machineScope,
+				images: fakeImages,
+			}
+			if err := s.Reconcile(context.TODO()); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			if fakeImages.filter != tt.wantFilter {
+				t.Errorf("Service.Reconcile() filter = %v, want %v", fakeImages.filter, tt.wantFilter)
+			}
+
+			if got := machineScope.GetImage(); pointer.StringDeref(got, "") != pointer.StringDeref(tt.want, "") {
+				t.Errorf("Service.Reconcile() image = %v, want %v", pointer.StringDeref(got, ""), pointer.StringDeref(tt.want, ""))
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_NoVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		lookup  *infrav1.ImageLookup
+		wantErr bool
+	}{
+		{
+			name:    "default image lookup (should fail instead of looking up a family without version)",
+			lookup:  &infrav1.ImageLookup{},
+			wantErr: true,
+		},
+		{
+			name:   "image lookup by labels (should resolve the newest image)",
+			lookup: &infrav1.ImageLookup{Labels: map[string]string{"os": "ubuntu"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machineScope := newMachineScope(t, tt.lookup)
+			machineScope.Machine.Spec.Version = nil
+			fakeImages := &fakeImages{images: []*compute.Image{
+				{Name: "capi-ubuntu-1804-k8s-v1-21-2-1620000000", CreationTimestamp: "2021-05-03T00:00:00.000-07:00"},
+			}}
+			s := &Service{
+				scope:  machineScope,
+				images: fakeImages,
+			}
+			err := s.Reconcile(context.TODO())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !strings.Contains(err.Error(), "the Machine has no Kubernetes version") {
+				t.Errorf("Service.Reconcile() error = %v, want a missing Kubernetes version error", err)
+			}
+		})
+	}
+}
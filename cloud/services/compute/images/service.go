@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type imagesInterface interface {
+	List(ctx context.Context, project, filter string) ([]*compute.Image, error)
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Machine
+	Project() string
+	Version() string
+	ImageLookup() *infrav1.ImageLookup
+	GetImage() *string
+	SetImage(image string)
+	RolloutImage(ctx context.Context) (*string, error)
+}
+
+// Service implements image lookups reconciler.
+type Service struct {
+	scope  Scope
+	images imagesInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:  scope,
+		images: &images{svc: scope.ComputeClient()},
+	}
+}
+
+// images adapts the compute client to imagesInterface.
+type images struct {
+	svc *compute.Service
+}
+
+func (i *images) List(ctx context.Context, project, filter string) ([]*compute.Image, error) {
+	var images []*compute.Image
+	err := i.svc.Images.List(project).Filter(filter).Pages(ctx, func(list *compute.ImageList) error {
+		images = append(images, list.Items...)
+		return nil
+	})
+	return images, err
+}
@@ -26,10 +26,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
 )
 
@@ -55,6 +54,20 @@ func (s *Service) Reconcile(ctx context.Context) error {
 				Address: ac.NatIP,
 			})
 		}
+
+		if iface.Ipv6Address != "" {
+			addresses = append(addresses, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: iface.Ipv6Address,
+			})
+		}
+
+		for _, ac := range iface.Ipv6AccessConfigs {
+			addresses = append(addresses, corev1.NodeAddress{
+				Type:    corev1.NodeExternalIP,
+				Address: ac.ExternalIpv6,
+			})
+		}
 	}
 
 	s.scope.SetProviderID()
@@ -74,8 +87,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 func (s *Service) Delete(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	log.Info("Deleting instance resources")
-	instanceSpec := s.scope.InstanceSpec()
-	instanceName := instanceSpec.Name
+	instanceName := s.scope.InstanceName()
 	instanceKey := meta.ZonalKey(instanceName, s.scope.Zone())
 	log.V(2).Info("Looking for instance before deleting", "name", instanceName, "zone", s.scope.Zone())
 	instance, err := s.instances.Get(ctx, instanceKey)
@@ -101,19 +113,68 @@ func (s *Service) Delete(ctx context.Context) error {
 func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, error) {
 	log := log.FromContext(ctx)
 	log.V(2).Info("Getting bootstrap data for machine")
-	bootstrapData, err := s.scope.GetBootstrapData()
+	bootstrapData, bootstrapDataFormat, err := s.scope.GetBootstrapDataWithFormat()
 	if err != nil {
 		log.Error(err, "Error getting bootstrap data for machine")
 		return nil, errors.Wrap(err, "failed to retrieve bootstrap data")
 	}
 
-	instanceSpec := s.scope.InstanceSpec()
+	if s.scope.Zone() == "" {
+		zones, err := s.scope.FailureDomainCandidates(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(zones) == 0 {
+			return nil, errors.New("failed to select a failure domain: cluster has no failure domains")
+		}
+
+		log.V(2).Info("Placing instance in failure domain", "zone", zones[0])
+		s.scope.SetFailureDomain(zones[0])
+	}
+
+	instance, err := s.getOrInsertInstance(ctx, bootstrapData, bootstrapDataFormat)
+	if err == nil || !gcperrors.IsZoneResourcePoolExhausted(err) || !s.scope.FailureDomainFallback() {
+		return instance, err
+	}
+
+	exhausted := s.scope.Zone()
+	zones, zerr := s.scope.FailureDomainCandidates(ctx)
+	if zerr != nil {
+		return nil, zerr
+	}
+
+	for _, zone := range zones {
+		if zone == exhausted {
+			continue
+		}
+
+		log.Info("Zone resource pool exhausted, retrying in another failure domain", "zone", s.scope.Zone(), "fallback", zone)
+		s.scope.SetFailureDomain(zone)
+		instance, err = s.getOrInsertInstance(ctx, bootstrapData, bootstrapDataFormat)
+		if err == nil || !gcperrors.IsZoneResourcePoolExhausted(err) {
+			return instance, err
+		}
+	}
+
+	return nil, err
+}
+
+func (s *Service) getOrInsertInstance(ctx context.Context, bootstrapData, bootstrapDataFormat string) (*compute.Instance, error) {
+	log := log.FromContext(ctx)
+	instanceSpec, err := s.scope.InstanceSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapMetadata, err := s.scope.InstanceBootstrapMetadataSpec(bootstrapData, bootstrapDataFormat)
+	if err != nil {
+		return nil, err
+	}
+
 	instanceName := instanceSpec.Name
 	instanceKey := meta.ZonalKey(instanceName, s.scope.Zone())
-	instanceSpec.Metadata.Items = append(instanceSpec.Metadata.Items, &compute.MetadataItems{
-		Key:   "user-data",
-		Value: pointer.StringPtr(bootstrapData),
-	})
+	instanceSpec.Metadata.Items = append(instanceSpec.Metadata.Items, bootstrapMetadata)
 
 	log.V(2).Info("Looking for instance", "name", instanceName, "zone", s.scope.Zone())
 	instance, err := s.instances.Get(ctx, instanceKey)
@@ -18,8 +18,10 @@ package instances
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
@@ -27,7 +29,7 @@ import (
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/pointer"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -81,8 +84,9 @@ var fakeGCPCluster = &infrav1.GCPCluster{
 		Namespace: "default",
 	},
 	Spec: infrav1.GCPClusterSpec{
-		Project: "my-proj",
-		Region:  "us-central1",
+		ResourceNamePrefix: pointer.String("my-cluster"),
+		Project:            "my-proj",
+		Region:             "us-central1",
 	},
 }
 
@@ -92,6 +96,9 @@ var fakeGCPMachine = &infrav1.GCPMachine{
 		Namespace: "default",
 	},
 	Spec: infrav1.GCPMachineSpec{},
+	Status: infrav1.GCPMachineStatus{
+		InstanceName: pointer.String("my-machine"),
+	},
 }
 
 func TestService_createOrGetInstance(t *testing.T) {
@@ -168,7 +175,12 @@ func TestService_createOrGetInstance(t *testing.T) {
 						AutoDelete: true,
 						Boot:       true,
 						InitializeParams: &compute.AttachedDiskInitializeParams{
-							DiskType:    "zones/us-central1-c/diskTypes/pd-standard",
+							DiskSizeGb: 30,
+							DiskType:   "zones/us-central1-c/diskTypes/pd-standard",
+							Labels: map[string]string{
+								"capg-role":               "node",
+								"capg-cluster-my-cluster": "owned",
+							},
 							SourceImage: "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19",
 						},
 					},
@@ -226,3 +238,608 @@ func TestService_createOrGetInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestService_createOrGetInstance_MovedMachine(t *testing.T) {
+	// clusterctl move doesn't preserve the status, the instance name is taken from the provider ID.
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Status = infrav1.GCPMachineStatus{}
+	gcpMachine.Spec.ProviderID = pointer.String("gce://my-proj/us-central1-c/my-machine-1a2b3c4d")
+
+	machineScope := newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret)
+	if got, want := machineScope.InstanceName(), "my-machine-1a2b3c4d"; got != want {
+		t.Fatalf("MachineScope.InstanceName() = %v, want %v", got, want)
+	}
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects: map[meta.Key]*cloud.MockInstancesObj{
+			{Name: "my-machine-1a2b3c4d", Zone: "us-central1-c"}: {Obj: &compute.Instance{
+				Name: "my-machine-1a2b3c4d",
+			}},
+		},
+		InsertHook: func(ctx context.Context, key *meta.Key, obj *compute.Instance, m *cloud.MockInstances) (bool, error) {
+			t.Errorf("Service.createOrGetInstance() created instance %v, want the existing one", key.Name)
+			return true, nil
+		},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	if want := "my-machine-1a2b3c4d"; got.Name != want {
+		t.Errorf("Service.createOrGetInstance() name = %v, want %v", got.Name, want)
+	}
+
+	if want := "gce://my-proj/us-central1-c/my-machine-1a2b3c4d"; machineScope.GetProviderID() != want {
+		t.Errorf("MachineScope.GetProviderID() = %v, want %v", machineScope.GetProviderID(), want)
+	}
+}
+
+func TestService_createOrGetInstance_FailureDomainFallback(t *testing.T) {
+	cluster := fakeCluster.DeepCopy()
+	gcpCluster := fakeGCPCluster.DeepCopy()
+	gcpCluster.Status.FailureDomains = clusterv1.FailureDomains{
+		"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+		"us-central1-b": clusterv1.FailureDomainSpec{ControlPlane: true},
+		"us-central1-c": clusterv1.FailureDomainSpec{ControlPlane: true},
+	}
+
+	machine := fakeMachine.DeepCopy()
+	machine.Labels = map[string]string{clusterv1.ClusterLabelName: "my-cluster"}
+	machine.Spec.FailureDomain = nil
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.FailureDomainFallback = true
+
+	otherMachine := fakeMachine.DeepCopy()
+	otherMachine.Name = "my-other-machine"
+	otherMachine.Labels = map[string]string{clusterv1.ClusterLabelName: "my-cluster"}
+	otherMachine.Spec.FailureDomain = pointer.String("us-central1-c")
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(fakeBootstrapSecret, machine, otherMachine).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     fakec,
+		Cluster:    cluster,
+		GCPCluster: gcpCluster,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:        fakec,
+		Machine:       machine,
+		GCPMachine:    gcpMachine,
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+		InsertError: map[meta.Key]error{
+			{Name: "my-machine", Zone: "us-central1-a"}: &googleapi.Error{
+				Code:    http.StatusServiceUnavailable,
+				Message: "ZONE_RESOURCE_POOL_EXHAUSTED - The zone does not have enough resources available to fulfill the request.",
+			},
+		},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	if got.Zone != "us-central1-b" {
+		t.Errorf("Service.createOrGetInstance() zone = %v, want %v", got.Zone, "us-central1-b")
+	}
+
+	if zone := machineScope.Zone(); zone != "us-central1-b" {
+		t.Errorf("MachineScope.Zone() = %v, want %v", zone, "us-central1-b")
+	}
+}
+
+func TestService_createOrGetInstance_Ignition(t *testing.T) {
+	bootstrapSecret := fakeBootstrapSecret.DeepCopy()
+	bootstrapSecret.Data = map[string][]byte{
+		"value":  []byte(`{"ignition":{"version":"3.1.0"},"storage":{"files":[]}}`),
+		"format": []byte("ignition"),
+	}
+
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.BootstrapDataStorage = infrav1.BootstrapDataStorageGCS
+	gcpMachine.Spec.BootstrapDataBucket = pointer.String("my-bucket")
+	gcpMachine.Spec.ImageFamilyTemplate = pointer.String("projects/{{.Project}}/global/images/family/capi-flatcar-stable-k8s-{{.KubernetesMinorVersion}}")
+
+	machineScope := newMachineScope(t, fakeMachine, gcpMachine, bootstrapSecret)
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	wantImage := "projects/my-proj/global/images/family/capi-flatcar-stable-k8s-v1-19"
+	if image := got.Disks[0].InitializeParams.SourceImage; image != wantImage {
+		t.Errorf("Service.createOrGetInstance() image = %v, want %v", image, wantImage)
+	}
+
+	wantUserData := `{"ignition":{"config":{"replace":{"source":"gs://my-bucket/my-cluster/my-machine-bootstrap"}},"version":"3.1.0"}}`
+	if userData := pointer.StringDeref(got.Metadata.Items[0].Value, ""); got.Metadata.Items[0].Key != "user-data" || userData != wantUserData {
+		t.Errorf("Service.createOrGetInstance() user-data = %v, want %v", userData, wantUserData)
+	}
+}
+
+func TestService_createOrGetInstance_SecretManager(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.BootstrapDataStorage = infrav1.BootstrapDataStorageSecretManager
+
+	machineScope := newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret)
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	if got.Metadata.Items[0].Key != "user-data" {
+		t.Fatalf("Service.createOrGetInstance() metadata key = %v, want user-data", got.Metadata.Items[0].Key)
+	}
+
+	script := pointer.StringDeref(got.Metadata.Items[0].Value, "")
+	if strings.Contains(script, "Zm9vCg==") {
+		t.Errorf("Service.createOrGetInstance() user-data contains the bootstrap data")
+	}
+
+	// The bootstrap data must be seeded as the user-data of a NoCloud datasource, only readable by root
+	// and deleted on exit, and cloud-init cleaned before it runs again, as the semaphores of the instance
+	// are used up by then.
+	wantLines := []string{
+		"#!/bin/bash",
+		fmt.Sprintf(`BOOTSTRAP_DATA_URL="https://secretmanager.googleapis.com/v1/projects/my-proj/secrets/%s/versions/latest:access"`, machineScope.BootstrapDataSecretName()),
+		"umask 077",
+		`install -d -m 0700 "${SEED_DIR}"`,
+		`trap 'rm -rf "${SEED_DIR}"' EXIT`,
+		`  install -m 0600 /dev/null "${SEED_DIR}/user-data"`,
+		"    seedfrom: file://${SEED_DIR}/",
+		"cloud-init clean",
+		`cloud-init --file "${SEED_DIR}/cloud.cfg" init`,
+		`cloud-init --file "${SEED_DIR}/cloud.cfg" modules --mode=config`,
+		`cloud-init --file "${SEED_DIR}/cloud.cfg" modules --mode=final`,
+		`touch "${STATE_DIR}/bootstrapped"`,
+	}
+	lines := strings.Split(script, "\n")
+	i := 0
+	for _, line := range lines {
+		if i < len(wantLines) && line == wantLines[i] {
+			i++
+		}
+	}
+	if i < len(wantLines) {
+		t.Errorf("Service.createOrGetInstance() user-data is missing %q in order, got:\n%s", wantLines[i], script)
+	}
+}
+
+func TestService_createOrGetInstance_NoVersion(t *testing.T) {
+	machine := fakeMachine.DeepCopy()
+	machine.Spec.Version = nil
+
+	machineScope := newMachineScope(t, machine, fakeGCPMachine, fakeBootstrapSecret)
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	if _, err := s.createOrGetInstance(context.TODO()); err == nil {
+		t.Errorf("Service.createOrGetInstance() expected an error for a Machine without a Kubernetes version")
+	}
+}
+
+func TestService_createOrGetInstance_AdditionalDisks(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.AdditionalDisks = []infrav1.AttachedDiskSpec{
+		{
+			Size: pointer.Int64(100),
+		},
+		{
+			DeviceType:      diskType(infrav1.PdExtremeDiskType),
+			Name:            pointer.String("my-data"),
+			ProvisionedIOPS: pointer.Int64(10000),
+			SourceSnapshot:  pointer.String("projects/my-proj/global/snapshots/my-snapshot"),
+			Labels:          infrav1.Labels{"disk": "data"},
+			AutoDelete:      pointer.Bool(false),
+		},
+		{
+			DeviceType: diskType(infrav1.LocalSsdDiskType),
+			Count:      pointer.Int32(2),
+		},
+	}
+
+	machineScope := newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret)
+
+	s := New(machineScope)
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	labels := map[string]string{
+		"capg-role":               "node",
+		"capg-cluster-my-cluster": "owned",
+	}
+	localSsd := &compute.AttachedDisk{
+		AutoDelete: true,
+		Type:       "SCRATCH",
+		InitializeParams: &compute.AttachedDiskInitializeParams{
+			DiskSizeGb: 375,
+			DiskType:   "zones/us-central1-c/diskTypes/local-ssd",
+		},
+		Interface: "NVME",
+	}
+	want := []*compute.AttachedDisk{
+		{
+			AutoDelete: true,
+			InitializeParams: &compute.AttachedDiskInitializeParams{
+				DiskSizeGb: 100,
+				DiskType:   "zones/us-central1-c/diskTypes/pd-standard",
+				Labels:     labels,
+			},
+		},
+		{
+			InitializeParams: &compute.AttachedDiskInitializeParams{
+				DiskName:        "my-data",
+				DiskSizeGb:      30,
+				DiskType:        "zones/us-central1-c/diskTypes/pd-extreme",
+				Labels:          map[string]string{"capg-role": "node", "capg-cluster-my-cluster": "owned", "disk": "data"},
+				ProvisionedIops: 10000,
+				SourceSnapshot:  "projects/my-proj/global/snapshots/my-snapshot",
+			},
+		},
+		localSsd,
+		localSsd,
+	}
+
+	if len(got.Disks) != len(want)+1 {
+		t.Fatalf("Service.createOrGetInstance() disks = %d, want %d", len(got.Disks), len(want)+1)
+	}
+
+	for i, disk := range got.Disks[1:] {
+		if !reflect.DeepEqual(disk, want[i]) {
+			t.Errorf("Service.createOrGetInstance() disk %d = %+v, want %+v", i, disk.InitializeParams, want[i].InitializeParams)
+		}
+	}
+}
+
+func diskType(t infrav1.DiskType) *infrav1.DiskType {
+	return &t
+}
+
+func TestService_createOrGetInstance_Affinities(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.NodeAffinities = []infrav1.NodeAffinity{
+		{
+			NodeGroup: pointer.String("my-node-group"),
+		},
+		{
+			Key:      "workload",
+			Operator: infrav1.NodeAffinityOperatorNotIn,
+			Values:   []string{"database"},
+		},
+	}
+	gcpMachine.Spec.ReservationAffinity = &infrav1.ReservationAffinity{
+		Type:         infrav1.ReservationAffinityTypeSpecific,
+		Reservations: []string{"my-reservation"},
+	}
+
+	s := New(newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret))
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	wantNodeAffinities := []*compute.SchedulingNodeAffinity{
+		{Key: "compute.googleapis.com/node-group-name", Operator: "IN", Values: []string{"my-node-group"}},
+		{Key: "workload", Operator: "NOT_IN", Values: []string{"database"}},
+	}
+	if !reflect.DeepEqual(got.Scheduling.NodeAffinities, wantNodeAffinities) {
+		t.Errorf("Service.createOrGetInstance() node affinities = %v, want %v", got.Scheduling.NodeAffinities, wantNodeAffinities)
+	}
+
+	wantReservationAffinity := &compute.ReservationAffinity{
+		ConsumeReservationType: "SPECIFIC_RESERVATION",
+		Key:                    "compute.googleapis.com/reservation-name",
+		Values:                 []string{"my-reservation"},
+	}
+	if !reflect.DeepEqual(got.ReservationAffinity, wantReservationAffinity) {
+		t.Errorf("Service.createOrGetInstance() reservation affinity = %v, want %v", got.ReservationAffinity, wantReservationAffinity)
+	}
+}
+
+func TestService_createOrGetInstance_PlacementPolicy(t *testing.T) {
+	gcpCluster := fakeGCPCluster.DeepCopy()
+	gcpCluster.Spec.PlacementPolicies = []infrav1.PlacementPolicySpec{
+		{Name: "spread"},
+		{Name: "compact", Collocation: infrav1.PlacementPolicyCollocationCompact},
+	}
+
+	tests := []struct {
+		name                  string
+		policy                *string
+		wantResourcePolicies  []string
+		wantOnHostMaintenance string
+	}{
+		{
+			name: "no placement policy (should keep the default maintenance behavior)",
+		},
+		{
+			name:                 "spread placement policy (should keep the default maintenance behavior)",
+			policy:               pointer.String("spread"),
+			wantResourcePolicies: []string{"projects/my-proj/regions/us-central1/resourcePolicies/my-cluster-spread"},
+		},
+		{
+			name:                  "compact placement policy (should terminate the instance on host maintenance)",
+			policy:                pointer.String("compact"),
+			wantResourcePolicies:  []string{"projects/my-proj/regions/us-central1/resourcePolicies/my-cluster-compact"},
+			wantOnHostMaintenance: "TERMINATE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakec := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(fakeBootstrapSecret).
+				Build()
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     fakec,
+				Cluster:    fakeCluster,
+				GCPCluster: gcpCluster.DeepCopy(),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gcpMachine := fakeGCPMachine.DeepCopy()
+			gcpMachine.Spec.PlacementPolicy = tt.policy
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:        fakec,
+				Machine:       fakeMachine,
+				GCPMachine:    gcpMachine,
+				ClusterGetter: clusterScope,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := New(machineScope)
+			s.instances = &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			}
+
+			got, err := s.createOrGetInstance(context.TODO())
+			if err != nil {
+				t.Fatalf("Service.createOrGetInstance() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got.ResourcePolicies, tt.wantResourcePolicies) {
+				t.Errorf("Service.createOrGetInstance() resource policies = %v, want %v", got.ResourcePolicies, tt.wantResourcePolicies)
+			}
+
+			if got.Scheduling.OnHostMaintenance != tt.wantOnHostMaintenance {
+				t.Errorf("Service.createOrGetInstance() on host maintenance = %v, want %v", got.Scheduling.OnHostMaintenance, tt.wantOnHostMaintenance)
+			}
+		})
+	}
+}
+
+func TestService_createOrGetInstance_CustomMachineType(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.InstanceType = ""
+	gcpMachine.Spec.CustomMachineType = &infrav1.CustomMachineType{
+		Series:         infrav1.CustomMachineTypeSeriesN2,
+		CPUs:           4,
+		MemoryMB:       40960,
+		ExtendedMemory: true,
+	}
+	gcpMachine.Spec.MinCPUPlatform = pointer.String("Intel Cascade Lake")
+	gcpMachine.Spec.AdvancedMachineFeatures = &infrav1.AdvancedMachineFeatures{
+		ThreadsPerCore:             pointer.Int64(1),
+		EnableNestedVirtualization: true,
+	}
+
+	s := New(newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret))
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	if want := "zones/us-central1-c/machineTypes/n2-custom-4-40960-ext"; got.MachineType != want {
+		t.Errorf("Service.createOrGetInstance() machine type = %v, want %v", got.MachineType, want)
+	}
+
+	if want := "Intel Cascade Lake"; got.MinCpuPlatform != want {
+		t.Errorf("Service.createOrGetInstance() min CPU platform = %v, want %v", got.MinCpuPlatform, want)
+	}
+
+	wantFeatures := &compute.AdvancedMachineFeatures{ThreadsPerCore: 1, EnableNestedVirtualization: true}
+	if !reflect.DeepEqual(got.AdvancedMachineFeatures, wantFeatures) {
+		t.Errorf("Service.createOrGetInstance() advanced machine features = %v, want %v", got.AdvancedMachineFeatures, wantFeatures)
+	}
+}
+
+func TestService_createOrGetInstance_GuestAccelerators(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Spec.GuestAccelerators = []infrav1.GuestAccelerator{{Type: "nvidia-tesla-t4", Count: 2}}
+
+	s := New(newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret))
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	want := []*compute.AcceleratorConfig{{AcceleratorType: "zones/us-central1-c/acceleratorTypes/nvidia-tesla-t4", AcceleratorCount: 2}}
+	if !reflect.DeepEqual(got.GuestAccelerators, want) {
+		t.Errorf("Service.createOrGetInstance() guest accelerators = %v, want %v", got.GuestAccelerators, want)
+	}
+
+	if want := "TERMINATE"; got.Scheduling.OnHostMaintenance != want {
+		t.Errorf("Service.createOrGetInstance() on host maintenance = %v, want %v", got.Scheduling.OnHostMaintenance, want)
+	}
+}
+
+func TestService_createOrGetInstance_NetworkInterfaces(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gvnic := infrav1.NicTypeGVNIC
+	gcpMachine.Spec.NetworkInterfaces = []infrav1.NetworkInterface{
+		{
+			Subnet: pointer.String("nodes"),
+			AliasIPRanges: []infrav1.AliasIPRange{
+				{IPCidrRange: "/24", SubnetworkRangeName: pointer.String("pods")},
+			},
+			PublicIP: pointer.Bool(true),
+			NicType:  &gvnic,
+		},
+		{
+			Network:   pointer.String("storage"),
+			Subnet:    pointer.String("storage"),
+			NetworkIP: pointer.String("10.10.0.5"),
+		},
+	}
+
+	s := New(newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret))
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	want := []*compute.NetworkInterface{
+		{
+			Network:    "projects/my-proj/global/networks/default",
+			Subnetwork: "regions/us-central1/subnetworks/nodes",
+			AliasIpRanges: []*compute.AliasIpRange{
+				{IpCidrRange: "/24", SubnetworkRangeName: "pods"},
+			},
+			AccessConfigs: []*compute.AccessConfig{
+				{Type: "ONE_TO_ONE_NAT", Name: "External NAT", NetworkTier: "PREMIUM"},
+			},
+			NicType: "GVNIC",
+		},
+		{
+			Network:    "projects/my-proj/global/networks/storage",
+			Subnetwork: "regions/us-central1/subnetworks/storage",
+			NetworkIP:  "10.10.0.5",
+		},
+	}
+	if !reflect.DeepEqual(got.NetworkInterfaces, want) {
+		t.Errorf("Service.createOrGetInstance() network interfaces = %v, want %v", got.NetworkInterfaces, want)
+	}
+}
+
+func TestService_createOrGetInstance_ReservedAddresses(t *testing.T) {
+	gcpMachine := fakeGCPMachine.DeepCopy()
+	gcpMachine.Status.ReservedInternalAddress = pointer.String("10.0.0.10")
+	gcpMachine.Status.ReservedExternalAddress = pointer.String("203.0.113.10")
+	standard := infrav1.NetworkTierStandard
+	gcpMachine.Spec.NetworkTier = &standard
+
+	s := New(newMachineScope(t, fakeMachine, gcpMachine, fakeBootstrapSecret))
+	s.instances = &cloud.MockInstances{
+		ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+		Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+	}
+
+	got, err := s.createOrGetInstance(context.TODO())
+	if err != nil {
+		t.Fatalf("Service.createOrGetInstance() error = %v", err)
+	}
+
+	want := []*compute.NetworkInterface{
+		{
+			Network:   "projects/my-proj/global/networks/default",
+			NetworkIP: "10.0.0.10",
+			AccessConfigs: []*compute.AccessConfig{
+				{Type: "ONE_TO_ONE_NAT", Name: "External NAT", NatIP: "203.0.113.10", NetworkTier: "STANDARD"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got.NetworkInterfaces, want) {
+		t.Errorf("Service.createOrGetInstance() network interfaces = %v, want %v", got.NetworkInterfaces, want)
+	}
+}
+
+func newMachineScope(t *testing.T, machine *clusterv1.Machine, gcpMachine *infrav1.GCPMachine, objs ...client.Object) *scope.MachineScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     fakec,
+		Cluster:    fakeCluster,
+		GCPCluster: fakeGCPCluster,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:        fakec,
+		Machine:       machine,
+		GCPMachine:    gcpMachine,
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
@@ -41,9 +41,10 @@ type instancegroupsInterface interface {
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	cloud.Machine
-	InstanceSpec() *compute.Instance
-	InstanceImageSpec() *compute.AttachedDisk
+	InstanceSpec() (*compute.Instance, error)
+	InstanceImageSpec() (*compute.AttachedDisk, error)
 	InstanceAdditionalDiskSpec() []*compute.AttachedDisk
+	InstanceBootstrapMetadataSpec(bootstrapData, format string) (*compute.MetadataItems, error)
 }
 
 // Service implements instances reconciler.
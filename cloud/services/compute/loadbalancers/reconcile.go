@@ -18,12 +18,20 @@ package loadbalancers
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"strings"
 
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/pkg/errors"
 	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 
 	"k8s.io/utils/pointer"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -47,23 +55,40 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return err
 	}
 
-	target, err := s.createOrGetTargetTCPProxy(ctx, backendsvc)
+	addr, err := s.createOrGetAddress(ctx)
 	if err != nil {
 		return err
 	}
 
-	addr, err := s.createOrGetAddress(ctx)
+	// Regional load balancers forward directly to the backend service.
+	if s.regional() {
+		return s.createForwardingRule(ctx, addr, "", backendsvc.SelfLink)
+	}
+
+	target, err := s.createOrGetTargetTCPProxy(ctx, backendsvc)
 	if err != nil {
 		return err
 	}
 
-	return s.createForwardingRule(ctx, target, addr)
+	if err := s.createForwardingRule(ctx, addr, target.SelfLink, ""); err != nil {
+		return err
+	}
+
+	if s.scope.APIServerStackType() == infrav1.StackTypeIPv4IPv6 {
+		return s.createIPv6Frontend(ctx, target.SelfLink)
+	}
+
+	return nil
 }
 
 // Delete delete cluster control-plane loadbalancer compoenents.
 func (s *Service) Delete(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	log.Info("Deleting loadbalancer resources")
+	if err := s.deleteIPv6Frontend(ctx); err != nil {
+		return err
+	}
+
 	if err := s.deleteForwardingRule(ctx); err != nil {
 		return err
 	}
@@ -72,8 +97,10 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
-	if err := s.deleteTargetTCPProxy(ctx); err != nil {
-		return err
+	if !s.regional() {
+		if err := s.deleteTargetTCPProxy(ctx); err != nil {
+			return err
+		}
 	}
 
 	if err := s.deleteBackendService(ctx); err != nil {
@@ -123,6 +150,11 @@ func (s *Service) createOrGetInstanceGroups(ctx context.Context) ([]*compute.Ins
 			}
 		}
 
+		if err := validateInstanceGroup(instancegroup, instancegroupSpec); err != nil {
+			log.Error(err, "Error adopting instancegroup", "name", instancegroupSpec.Name)
+			return groups, err
+		}
+
 		groups = append(groups, instancegroup)
 		groupsMap[zone] = instancegroup.SelfLink
 	}
@@ -135,7 +167,7 @@ func (s *Service) createOrGetHealthCheck(ctx context.Context) (*compute.HealthCh
 	log := log.FromContext(ctx)
 	healthcheckSpec := s.scope.HealthCheckSpec()
 	log.V(2).Info("Looking for healthcheck", "name", healthcheckSpec.Name)
-	healthcheck, err := s.healthchecks.Get(ctx, meta.GlobalKey(healthcheckSpec.Name))
+	healthcheck, err := s.healthchecks.Get(ctx, s.key(healthcheckSpec.Name))
 	if err != nil {
 		if !gcperrors.IsNotFound(err) {
 			log.Error(err, "Error looking for healthcheck", "name", healthcheckSpec.Name)
@@ -143,17 +175,22 @@ func (s *Service) createOrGetHealthCheck(ctx context.Context) (*compute.HealthCh
 		}
 
 		log.V(2).Info("Creating a healthcheck", "name", healthcheckSpec.Name)
-		if err := s.healthchecks.Insert(ctx, meta.GlobalKey(healthcheckSpec.Name), healthcheckSpec); err != nil {
+		if err := s.healthchecks.Insert(ctx, s.key(healthcheckSpec.Name), healthcheckSpec); err != nil {
 			log.Error(err, "Error creating a healthcheck", "name", healthcheckSpec.Name)
 			return nil, err
 		}
 
-		healthcheck, err = s.healthchecks.Get(ctx, meta.GlobalKey(healthcheckSpec.Name))
+		healthcheck, err = s.healthchecks.Get(ctx, s.key(healthcheckSpec.Name))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if err := validateHealthCheck(healthcheck, healthcheckSpec); err != nil {
+		log.Error(err, "Error adopting healthcheck", "name", healthcheckSpec.Name)
+		return nil, err
+	}
+
 	s.scope.Network().APIServerHealthCheck = pointer.String(healthcheck.SelfLink)
 	return healthcheck, nil
 }
@@ -162,16 +199,13 @@ func (s *Service) createOrGetBackendService(ctx context.Context, instancegroups
 	log := log.FromContext(ctx)
 	backends := make([]*compute.Backend, 0, len(instancegroups))
 	for _, group := range instancegroups {
-		backends = append(backends, &compute.Backend{
-			BalancingMode: "UTILIZATION",
-			Group:         group.SelfLink,
-		})
+		backends = append(backends, s.scope.BackendSpec(group.SelfLink))
 	}
 
 	backendsvcSpec := s.scope.BackendServiceSpec()
 	backendsvcSpec.Backends = backends
 	backendsvcSpec.HealthChecks = []string{healthcheck.SelfLink}
-	backendsvc, err := s.backendservices.Get(ctx, meta.GlobalKey(backendsvcSpec.Name))
+	backendsvc, err := s.backendservices.Get(ctx, s.key(backendsvcSpec.Name))
 	if err != nil {
 		if !gcperrors.IsNotFound(err) {
 			log.Error(err, "Error looking for backendservice", "name", backendsvcSpec.Name)
@@ -179,30 +213,103 @@ func (s *Service) createOrGetBackendService(ctx context.Context, instancegroups
 		}
 
 		log.V(2).Info("Creating a backendservice", "name", backendsvcSpec.Name)
-		if err := s.backendservices.Insert(ctx, meta.GlobalKey(backendsvcSpec.Name), backendsvcSpec); err != nil {
+		if err := s.backendservices.Insert(ctx, s.key(backendsvcSpec.Name), backendsvcSpec); err != nil {
 			log.Error(err, "Error creating a backendservice", "name", backendsvcSpec.Name)
 			return nil, err
 		}
 
-		backendsvc, err = s.backendservices.Get(ctx, meta.GlobalKey(backendsvcSpec.Name))
+		backendsvc, err = s.backendservices.Get(ctx, s.key(backendsvcSpec.Name))
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if len(backendsvc.Backends) != len(backendsvcSpec.Backends) {
+	if err := validateBackendService(backendsvc, backendsvcSpec); err != nil {
+		log.Error(err, "Error adopting backendservice", "name", backendsvcSpec.Name)
+		return nil, err
+	}
+
+	if backendServiceNeedsUpdate(backendsvc, backendsvcSpec) {
 		log.V(2).Info("Updating a backendservice", "name", backendsvcSpec.Name)
 		backendsvc.Backends = backendsvcSpec.Backends
-		if err := s.backendservices.Update(ctx, meta.GlobalKey(backendsvcSpec.Name), backendsvc); err != nil {
+		backendsvc.SessionAffinity = backendsvcSpec.SessionAffinity
+		if backendsvcSpec.TimeoutSec != 0 {
+			backendsvc.TimeoutSec = backendsvcSpec.TimeoutSec
+		}
+		if backendsvcSpec.ConnectionDraining != nil {
+			backendsvc.ConnectionDraining = backendsvcSpec.ConnectionDraining
+		}
+		if err := s.backendservices.Update(ctx, s.key(backendsvcSpec.Name), backendsvc); err != nil {
 			log.Error(err, "Error updating a backendservice", "name", backendsvcSpec.Name)
 			return nil, err
 		}
 	}
 
+	// Security policies can only be attached to the backend services of global load balancers.
+	if !s.regional() {
+		if err := s.setSecurityPolicy(ctx, backendsvc); err != nil {
+			return nil, err
+		}
+	}
+
 	s.scope.Network().APIServerBackendService = pointer.String(backendsvc.SelfLink)
 	return backendsvc, nil
 }
 
+// backendServiceNeedsUpdate reports whether the backends or the settings of a backend service differ from its spec.
+// Settings left unset in the spec keep the defaults of the API.
+func backendServiceNeedsUpdate(backendsvc, spec *compute.BackendService) bool {
+	if len(backendsvc.Backends) != len(spec.Backends) {
+		return true
+	}
+
+	backends := make(map[string]*compute.Backend, len(backendsvc.Backends))
+	for _, backend := range backendsvc.Backends {
+		backends[backend.Group] = backend
+	}
+	for _, want := range spec.Backends {
+		got, ok := backends[want.Group]
+		if !ok || got.BalancingMode != want.BalancingMode || got.MaxConnectionsPerInstance != want.MaxConnectionsPerInstance {
+			return true
+		}
+	}
+
+	if spec.TimeoutSec != 0 && backendsvc.TimeoutSec != spec.TimeoutSec {
+		return true
+	}
+
+	if spec.ConnectionDraining != nil && (backendsvc.ConnectionDraining == nil ||
+		backendsvc.ConnectionDraining.DrainingTimeoutSec != spec.ConnectionDraining.DrainingTimeoutSec) {
+		return true
+	}
+
+	return backendsvc.SessionAffinity != spec.SessionAffinity
+}
+
+// setSecurityPolicy attaches the security policy of the spec to the backend service, or detaches
+// the current one when the spec has none.
+func (s *Service) setSecurityPolicy(ctx context.Context, backendsvc *compute.BackendService) error {
+	log := log.FromContext(ctx)
+	spec := s.scope.SecurityPolicySpec()
+	if spec == nil {
+		if backendsvc.SecurityPolicy == "" {
+			return nil
+		}
+
+		spec = &compute.SecurityPolicyReference{}
+	} else if strings.HasSuffix(backendsvc.SecurityPolicy, spec.SecurityPolicy) {
+		return nil
+	}
+
+	log.V(2).Info("Setting backendservice security policy", "name", backendsvc.Name, "securityPolicy", spec.SecurityPolicy)
+	if err := s.securitypolicies.SetSecurityPolicy(ctx, s.key(backendsvc.Name), spec); err != nil {
+		log.Error(err, "Error setting backendservice security policy", "name", backendsvc.Name)
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) createOrGetTargetTCPProxy(ctx context.Context, service *compute.BackendService) (*computebeta.TargetTcpProxy, error) {
 	log := log.FromContext(ctx)
 	targetSpec := s.scope.TargetTCPProxySpec()
@@ -226,6 +333,11 @@ func (s *Service) createOrGetTargetTCPProxy(ctx context.Context, service *comput
 		}
 	}
 
+	if err := validateTargetTCPProxy(target, targetSpec); err != nil {
+		log.Error(err, "Error adopting targettcpproxy", "name", targetSpec.Name)
+		return nil, err
+	}
+
 	s.scope.Network().APIServerTargetProxy = pointer.String(target.SelfLink)
 	return target, nil
 }
@@ -233,39 +345,93 @@ func (s *Service) createOrGetTargetTCPProxy(ctx context.Context, service *comput
 func (s *Service) createOrGetAddress(ctx context.Context) (*compute.Address, error) {
 	log := log.FromContext(ctx)
 	addrSpec := s.scope.AddressSpec()
-	log.V(2).Info("Looking for address", "name", addrSpec.Name)
-	addr, err := s.addresses.Get(ctx, meta.GlobalKey(addrSpec.Name))
+	addr, err := s.getAddressRef(ctx)
 	if err != nil {
-		if !gcperrors.IsNotFound(err) {
-			log.Error(err, "Error looking for address", "name", addrSpec.Name)
-			return nil, err
-		}
+		return nil, err
+	}
 
-		log.V(2).Info("Creating an address", "name", addrSpec.Name)
-		if err := s.addresses.Insert(ctx, meta.GlobalKey(addrSpec.Name), addrSpec); err != nil {
-			log.Error(err, "Error creating an address", "name", addrSpec.Name)
-			return nil, err
+	if addr == nil {
+		log.V(2).Info("Looking for address", "name", addrSpec.Name)
+		addr, err = s.addresses.Get(ctx, s.key(addrSpec.Name))
+		if err != nil {
+			if !gcperrors.IsNotFound(err) {
+				log.Error(err, "Error looking for address", "name", addrSpec.Name)
+				return nil, err
+			}
+
+			log.V(2).Info("Creating an address", "name", addrSpec.Name)
+			if err := s.addresses.Insert(ctx, s.key(addrSpec.Name), addrSpec); err != nil {
+				log.Error(err, "Error creating an address", "name", addrSpec.Name)
+				return nil, err
+			}
+
+			addr, err = s.addresses.Get(ctx, s.key(addrSpec.Name))
+			if err != nil {
+				return nil, err
+			}
 		}
+	}
 
-		addr, err = s.addresses.Get(ctx, meta.GlobalKey(addrSpec.Name))
+	if err := validateAddress(addr, addrSpec); err != nil {
+		log.Error(err, "Error adopting address", "name", addr.Name)
+		return nil, err
+	}
+
+	s.scope.Network().APIServerAddress = pointer.String(addr.SelfLink)
+	s.scope.Network().APIServerIP = pointer.String(addr.Address)
+
+	// A managed DNS name is set as the endpoint host once its record is published.
+	if s.scope.APIServerDNSName() == "" {
+		endpoint := s.scope.ControlPlaneEndpoint()
+		endpoint.Host = addr.Address
+		s.scope.SetControlPlaneEndpoint(endpoint)
+	}
+
+	return addr, nil
+}
+
+// getAddressRef returns the existing address referenced by the load balancer spec, by name or by IP,
+// or nil when an address is reserved for the cluster.
+func (s *Service) getAddressRef(ctx context.Context) (*compute.Address, error) {
+	log := log.FromContext(ctx)
+	ref := s.scope.APIServerAddressRef()
+	if ref == "" {
+		return nil, nil
+	}
+
+	log.V(2).Info("Looking for existing address", "address", ref)
+	if net.ParseIP(ref) == nil {
+		addr, err := s.addresses.Get(ctx, s.key(ref))
 		if err != nil {
+			log.Error(err, "Error looking for existing address", "address", ref)
 			return nil, err
 		}
+
+		return addr, nil
 	}
 
-	s.scope.Network().APIServerAddress = pointer.String(addr.SelfLink)
-	endpoint := s.scope.ControlPlaneEndpoint()
-	endpoint.Host = addr.Address
-	s.scope.SetControlPlaneEndpoint(endpoint)
-	return addr, nil
+	addrs, err := s.addresslister.List(ctx, filter.Regexp("address", regexp.QuoteMeta(ref)))
+	if err != nil {
+		log.Error(err, "Error looking for existing address", "address", ref)
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		if addr.Address == ref {
+			return addr, nil
+		}
+	}
+
+	return nil, errors.Errorf("address %q not found", ref)
 }
 
-func (s *Service) createForwardingRule(ctx context.Context, target *computebeta.TargetTcpProxy, addr *compute.Address) error {
+func (s *Service) createForwardingRule(ctx context.Context, addr *compute.Address, target, backendService string) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.ForwardingRuleSpec()
-	key := meta.GlobalKey(spec.Name)
+	key := s.key(spec.Name)
 	spec.IPAddress = addr.SelfLink
-	spec.Target = target.SelfLink
+	spec.Target = target
+	spec.BackendService = backendService
 	log.V(2).Info("Looking for forwardingrule", "name", spec.Name)
 	forwarding, err := s.forwardingrules.Get(ctx, key)
 	if err != nil {
@@ -286,6 +452,11 @@ func (s *Service) createForwardingRule(ctx context.Context, target *computebeta.
 		}
 	}
 
+	if err := validateForwardingRule(forwarding, spec, addr); err != nil {
+		log.Error(err, "Error adopting forwardingrule", "name", spec.Name)
+		return err
+	}
+
 	s.scope.Network().APIServerForwardingRule = pointer.String(forwarding.SelfLink)
 	return nil
 }
@@ -293,7 +464,7 @@ func (s *Service) createForwardingRule(ctx context.Context, target *computebeta.
 func (s *Service) deleteForwardingRule(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.ForwardingRuleSpec()
-	key := meta.GlobalKey(spec.Name)
+	key := s.key(spec.Name)
 	log.V(2).Info("Deleting a forwardingrule", "name", spec.Name)
 	if err := s.forwardingrules.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
 		log.Error(err, "Error updating a forwardingrule", "name", spec.Name)
@@ -307,20 +478,24 @@ func (s *Service) deleteForwardingRule(ctx context.Context) error {
 func (s *Service) deleteAddress(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.AddressSpec()
-	key := meta.GlobalKey(spec.Name)
-	log.V(2).Info("Deleting a address", "name", spec.Name)
-	if err := s.addresses.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
-		return err
+	key := s.key(spec.Name)
+	// An existing address referenced by the spec isn't owned by the cluster.
+	if s.scope.APIServerAddressRef() == "" {
+		log.V(2).Info("Deleting a address", "name", spec.Name)
+		if err := s.addresses.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
+			return err
+		}
 	}
 
 	s.scope.Network().APIServerAddress = nil
+	s.scope.Network().APIServerIP = nil
 	return nil
 }
 
 func (s *Service) deleteTargetTCPProxy(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.TargetTCPProxySpec()
-	key := meta.GlobalKey(spec.Name)
+	key := s.key(spec.Name)
 	log.V(2).Info("Deleting a targettcpproxy", "name", spec.Name)
 	if err := s.targettcpproxies.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
 		log.Error(err, "Error deleting a targettcpproxy", "name", spec.Name)
@@ -334,7 +509,7 @@ func (s *Service) deleteTargetTCPProxy(ctx context.Context) error {
 func (s *Service) deleteBackendService(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.BackendServiceSpec()
-	key := meta.GlobalKey(spec.Name)
+	key := s.key(spec.Name)
 	log.V(2).Info("Deleting a backendservice", "name", spec.Name)
 	if err := s.backendservices.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
 		log.Error(err, "Error deleting a backendservice", "name", spec.Name)
@@ -348,7 +523,7 @@ func (s *Service) deleteBackendService(ctx context.Context) error {
 func (s *Service) deleteHealthCheck(ctx context.Context) error {
 	log := log.FromContext(ctx)
 	spec := s.scope.HealthCheckSpec()
-	key := meta.GlobalKey(spec.Name)
+	key := s.key(spec.Name)
 	log.V(2).Info("Deleting a healthcheck", "name", spec.Name)
 	if err := s.healthchecks.Delete(ctx, key); err != nil && !gcperrors.IsNotFound(err) {
 		log.Error(err, "Error deleting a healthcheck", "name", spec.Name)
@@ -377,3 +552,224 @@ func (s *Service) deleteInstanceGroups(ctx context.Context) error {
 
 	return nil
 }
+
+// createIPv6Frontend creates the IPv6 address and forwarding rule of the API server if not exist.
+func (s *Service) createIPv6Frontend(ctx context.Context, target string) error {
+	log := log.FromContext(ctx)
+	addrSpec := s.scope.AddressIPv6Spec()
+	log.V(2).Info("Looking for address", "name", addrSpec.Name)
+	addr, err := s.addresses.Get(ctx, meta.GlobalKey(addrSpec.Name))
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for address", "name", addrSpec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating an address", "name", addrSpec.Name)
+		if err := s.addresses.Insert(ctx, meta.GlobalKey(addrSpec.Name), addrSpec); err != nil {
+			log.Error(err, "Error creating an address", "name", addrSpec.Name)
+			return err
+		}
+
+		addr, err = s.addresses.Get(ctx, meta.GlobalKey(addrSpec.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateAddress(addr, addrSpec); err != nil {
+		log.Error(err, "Error adopting address", "name", addr.Name)
+		return err
+	}
+
+	s.scope.Network().APIServerIPv6Address = pointer.String(addr.SelfLink)
+	s.scope.Network().APIServerIPv6 = pointer.String(addr.Address)
+
+	spec := s.scope.ForwardingRuleIPv6Spec()
+	spec.IPAddress = addr.SelfLink
+	spec.Target = target
+	log.V(2).Info("Looking for forwardingrule", "name", spec.Name)
+	forwarding, err := s.forwardingrules.Get(ctx, meta.GlobalKey(spec.Name))
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for forwardingrule", "name", spec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating a forwardingrule", "name", spec.Name)
+		if err := s.forwardingrules.Insert(ctx, meta.GlobalKey(spec.Name), spec); err != nil {
+			log.Error(err, "Error creating a forwardingrule", "name", spec.Name)
+			return err
+		}
+
+		forwarding, err = s.forwardingrules.Get(ctx, meta.GlobalKey(spec.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateForwardingRule(forwarding, spec, addr); err != nil {
+		log.Error(err, "Error adopting forwardingrule", "name", spec.Name)
+		return err
+	}
+
+	s.scope.Network().APIServerIPv6ForwardingRule = pointer.String(forwarding.SelfLink)
+	return nil
+}
+
+// deleteIPv6Frontend deletes the IPv6 forwarding rule and address of the API server.
+func (s *Service) deleteIPv6Frontend(ctx context.Context) error {
+	if s.scope.APIServerStackType() != infrav1.StackTypeIPv4IPv6 && s.scope.Network().APIServerIPv6ForwardingRule == nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	spec := s.scope.ForwardingRuleIPv6Spec()
+	log.V(2).Info("Deleting a forwardingrule", "name", spec.Name)
+	if err := s.forwardingrules.Delete(ctx, meta.GlobalKey(spec.Name)); err != nil && !gcperrors.IsNotFound(err) {
+		log.Error(err, "Error deleting a forwardingrule", "name", spec.Name)
+		return err
+	}
+
+	s.scope.Network().APIServerIPv6ForwardingRule = nil
+
+	addrSpec := s.scope.AddressIPv6Spec()
+	log.V(2).Info("Deleting a address", "name", addrSpec.Name)
+	if err := s.addresses.Delete(ctx, meta.GlobalKey(addrSpec.Name)); err != nil && !gcperrors.IsNotFound(err) {
+		return err
+	}
+
+	s.scope.Network().APIServerIPv6Address = nil
+	s.scope.Network().APIServerIPv6 = nil
+	return nil
+}
+
+// adoptionError returns the error reported when an existing load balancer component doesn't match its spec.
+func adoptionError(kind, name, field string, got, want interface{}) error {
+	return errors.Errorf("existing %s %q can't be adopted: %s is %v, want %v", kind, name, field, got, want)
+}
+
+// validateAddress checks that an existing address can front the API server load balancer.
+func validateAddress(addr, spec *compute.Address) error {
+	if addr.AddressType != "" && addr.AddressType != spec.AddressType {
+		return adoptionError("address", addr.Name, "addressType", addr.AddressType, spec.AddressType)
+	}
+
+	if tier := networkTier(addr.NetworkTier); tier != networkTier(spec.NetworkTier) {
+		return adoptionError("address", addr.Name, "networkTier", tier, networkTier(spec.NetworkTier))
+	}
+
+	if addr.IpVersion != "" && addr.IpVersion != spec.IpVersion {
+		return adoptionError("address", addr.Name, "ipVersion", addr.IpVersion, spec.IpVersion)
+	}
+
+	return nil
+}
+
+// networkTier returns the network tier of a resource, where an unset tier means the Premium tier.
+func networkTier(tier string) string {
+	if tier == "" {
+		return "PREMIUM"
+	}
+
+	return tier
+}
+
+// validateInstanceGroup checks that an existing instance group exposes the API server port.
+func validateInstanceGroup(group, spec *compute.InstanceGroup) error {
+	for _, want := range spec.NamedPorts {
+		found := false
+		for _, port := range group.NamedPorts {
+			if port.Name == want.Name && port.Port == want.Port {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return adoptionError("instancegroup", group.Name, fmt.Sprintf("named port %q", want.Name), "missing", want.Port)
+		}
+	}
+
+	return nil
+}
+
+// validateHealthCheck checks that an existing health check probes the API server port.
+func validateHealthCheck(healthcheck, spec *compute.HealthCheck) error {
+	if healthcheck.Type != spec.Type {
+		return adoptionError("healthcheck", healthcheck.Name, "type", healthcheck.Type, spec.Type)
+	}
+
+	if spec.SslHealthCheck != nil {
+		if healthcheck.SslHealthCheck == nil || healthcheck.SslHealthCheck.Port != spec.SslHealthCheck.Port {
+			return adoptionError("healthcheck", healthcheck.Name, "port", sslHealthCheckPort(healthcheck), spec.SslHealthCheck.Port)
+		}
+	}
+
+	return nil
+}
+
+func sslHealthCheckPort(healthcheck *compute.HealthCheck) int64 {
+	if healthcheck.SslHealthCheck == nil {
+		return 0
+	}
+
+	return healthcheck.SslHealthCheck.Port
+}
+
+// validateBackendService checks the settings of an existing backend service that can't be updated.
+func validateBackendService(backendsvc, spec *compute.BackendService) error {
+	if backendsvc.LoadBalancingScheme != spec.LoadBalancingScheme {
+		return adoptionError("backendservice", backendsvc.Name, "loadBalancingScheme", backendsvc.LoadBalancingScheme, spec.LoadBalancingScheme)
+	}
+
+	if backendsvc.Protocol != spec.Protocol {
+		return adoptionError("backendservice", backendsvc.Name, "protocol", backendsvc.Protocol, spec.Protocol)
+	}
+
+	if backendsvc.PortName != spec.PortName {
+		return adoptionError("backendservice", backendsvc.Name, "portName", backendsvc.PortName, spec.PortName)
+	}
+
+	return nil
+}
+
+// validateTargetTCPProxy checks that an existing target proxy routes to the backend service of the cluster.
+func validateTargetTCPProxy(target, spec *computebeta.TargetTcpProxy) error {
+	if path.Base(target.Service) != path.Base(spec.Service) {
+		return adoptionError("targettcpproxy", target.Name, "service", target.Service, spec.Service)
+	}
+
+	return nil
+}
+
+// validateForwardingRule checks that an existing forwarding rule serves the API server
+// on the address and the target of the cluster.
+func validateForwardingRule(rule, spec *compute.ForwardingRule, addr *compute.Address) error {
+	if rule.IPProtocol != spec.IPProtocol {
+		return adoptionError("forwardingrule", rule.Name, "IPProtocol", rule.IPProtocol, spec.IPProtocol)
+	}
+
+	if rule.PortRange != spec.PortRange {
+		return adoptionError("forwardingrule", rule.Name, "portRange", rule.PortRange, spec.PortRange)
+	}
+
+	if rule.LoadBalancingScheme != spec.LoadBalancingScheme {
+		return adoptionError("forwardingrule", rule.Name, "loadBalancingScheme", rule.LoadBalancingScheme, spec.LoadBalancingScheme)
+	}
+
+	// The API returns the IP of the address, while the spec refers to its self link.
+	if rule.IPAddress != addr.Address && rule.IPAddress != addr.SelfLink {
+		return adoptionError("forwardingrule", rule.Name, "IPAddress", rule.IPAddress, addr.Address)
+	}
+
+	if path.Base(rule.Target) != path.Base(spec.Target) {
+		return adoptionError("forwardingrule", rule.Name, "target", rule.Target, spec.Target)
+	}
+
+	if path.Base(rule.BackendService) != path.Base(spec.BackendService) {
+		return adoptionError("forwardingrule", rule.Name, "backendService", rule.BackendService, spec.BackendService)
+	}
+
+	return nil
+}
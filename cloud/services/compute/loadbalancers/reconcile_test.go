@@ -0,0 +1,610 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/mock"
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+func TestService_Reconcile_StandardNetworkTier(t *testing.T) {
+	standard := infrav1.NetworkTierStandard
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+				NetworkTier:        &standard,
+			},
+			Status: infrav1.GCPClusterStatus{
+				FailureDomains: clusterv1.FailureDomains{
+					"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	s := New(clusterScope)
+	s.addresses = mock.Addresses()
+	s.backendservices = mock.RegionBackendServices()
+	s.forwardingrules = mock.ForwardingRules()
+	s.healthchecks = mock.RegionHealthChecks()
+	s.instancegroups = mock.InstanceGroups()
+	s.targettcpproxies = mock.BetaTargetTcpProxies()
+
+	ctx := context.TODO()
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	key := meta.RegionalKey("my-cluster-apiserver", "us-central1")
+	rule, err := mock.ForwardingRules().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("ForwardingRules.Get() error = %v", err)
+	}
+	if rule.NetworkTier != "STANDARD" || rule.PortRange != "6443-6443" || rule.Target != "" {
+		t.Errorf("Service.Reconcile() forwarding rule = %+v, want a STANDARD rule on port 6443 without target", rule)
+	}
+
+	backendsvc, err := mock.RegionBackendServices().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("RegionBackendServices.Get() error = %v", err)
+	}
+	if rule.BackendService != backendsvc.SelfLink {
+		t.Errorf("Service.Reconcile() forwarding rule backend service = %v, want %v", rule.BackendService, backendsvc.SelfLink)
+	}
+	if len(backendsvc.Backends) != 1 || backendsvc.Backends[0].BalancingMode != "CONNECTION" {
+		t.Errorf("Service.Reconcile() backends = %v, want one backend with the CONNECTION balancing mode", backendsvc.Backends)
+	}
+
+	addr, err := mock.Addresses().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Addresses.Get() error = %v", err)
+	}
+	if addr.NetworkTier != "STANDARD" {
+		t.Errorf("Service.Reconcile() address network tier = %v, want STANDARD", addr.NetworkTier)
+	}
+
+	if ip := clusterScope.Network().APIServerIP; ip == nil || *ip != addr.Address {
+		t.Errorf("Service.Reconcile() API server IP = %v, want %v", ip, addr.Address)
+	}
+	if host := clusterScope.ControlPlaneEndpoint().Host; host != addr.Address {
+		t.Errorf("ClusterScope.ControlPlaneEndpoint() host = %v, want %v", host, addr.Address)
+	}
+
+	if port := clusterScope.ControlPlaneEndpoint().Port; port != 6443 {
+		t.Errorf("ClusterScope.ControlPlaneEndpoint() port = %v, want 6443", port)
+	}
+
+	if err := s.Delete(ctx); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if _, err := mock.ForwardingRules().Get(ctx, key); err == nil {
+		t.Errorf("Service.Delete() did not delete the forwarding rule")
+	}
+}
+
+func TestService_Reconcile_LoadBalancerSpec(t *testing.T) {
+	proxyHeader := infrav1.ProxyHeaderProxyV1
+	sessionAffinity := infrav1.SessionAffinityClientIP
+	balancingMode := infrav1.BalancingModeConnection
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: clusterv1.ClusterSpec{
+				ClusterNetwork: &clusterv1.ClusterNetwork{},
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+				LoadBalancer: &infrav1.LoadBalancerSpec{
+					ProxyHeader:                  &proxyHeader,
+					BackendTimeoutSec:            pointer.Int64(30),
+					ConnectionDrainingTimeoutSec: pointer.Int64(60),
+					SessionAffinity:              &sessionAffinity,
+					BalancingMode:                &balancingMode,
+					MaxConnectionsPerInstance:    pointer.Int64(100),
+					SecurityPolicy:               pointer.String("allow-office"),
+				},
+			},
+			Status: infrav1.GCPClusterStatus{
+				FailureDomains: clusterv1.FailureDomains{
+					"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	var securityPolicy *compute.SecurityPolicyReference
+	gce.MockBackendServices.SetSecurityPolicyHook = func(_ context.Context, _ *meta.Key, ref *compute.SecurityPolicyReference, _ *cloud.MockBackendServices) error {
+		securityPolicy = ref
+		return nil
+	}
+	gce.MockBackendServices.UpdateHook = mock.UpdateBackendServiceHook
+	s := New(clusterScope)
+	s.addresses = gce.GlobalAddresses()
+	s.backendservices = gce.BackendServices()
+	s.forwardingrules = gce.GlobalForwardingRules()
+	s.healthchecks = gce.HealthChecks()
+	s.instancegroups = gce.InstanceGroups()
+	s.targettcpproxies = gce.BetaTargetTcpProxies()
+	s.securitypolicies = gce.BackendServices()
+
+	ctx := context.TODO()
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	key := meta.GlobalKey("my-cluster-apiserver")
+	proxy, err := gce.BetaTargetTcpProxies().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("TargetTcpProxies.Get() error = %v", err)
+	}
+	if proxy.ProxyHeader != "PROXY_V1" {
+		t.Errorf("Service.Reconcile() proxy header = %v, want PROXY_V1", proxy.ProxyHeader)
+	}
+
+	backendsvc, err := gce.BackendServices().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("BackendServices.Get() error = %v", err)
+	}
+	if backendsvc.TimeoutSec != 30 || backendsvc.ConnectionDraining.DrainingTimeoutSec != 60 || backendsvc.SessionAffinity != "CLIENT_IP" {
+		t.Errorf("Service.Reconcile() backend service = %+v, want a 30s timeout, 60s connection draining and CLIENT_IP affinity", backendsvc)
+	}
+	if len(backendsvc.Backends) != 1 || backendsvc.Backends[0].BalancingMode != "CONNECTION" || backendsvc.Backends[0].MaxConnectionsPerInstance != 100 {
+		t.Errorf("Service.Reconcile() backends = %v, want one backend with the CONNECTION balancing mode and 100 connections", backendsvc.Backends)
+	}
+	if want := "projects/my-proj/global/securityPolicies/allow-office"; securityPolicy == nil || securityPolicy.SecurityPolicy != want {
+		t.Errorf("Service.Reconcile() security policy = %+v, want %v", securityPolicy, want)
+	}
+
+	clusterScope.GCPCluster.Spec.LoadBalancer.BackendTimeoutSec = pointer.Int64(45)
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	backendsvc, err = gce.BackendServices().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("BackendServices.Get() error = %v", err)
+	}
+	if backendsvc.TimeoutSec != 45 {
+		t.Errorf("Service.Reconcile() backend service timeout = %v, want 45", backendsvc.TimeoutSec)
+	}
+}
+
+func TestService_Reconcile_APIServerAddress(t *testing.T) {
+	standard := infrav1.NetworkTierStandard
+	tests := []struct {
+		name    string
+		ref     string
+		wantErr bool
+	}{
+		{
+			name: "address referenced by name",
+			ref:  "my-address",
+		},
+		{
+			name: "address referenced by IP",
+			ref:  "203.0.113.10",
+		},
+		{
+			name:    "address not found",
+			ref:     "203.0.113.11",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+				},
+				GCPCluster: &infrav1.GCPCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+					Spec: infrav1.GCPClusterSpec{
+						ResourceNamePrefix: pointer.String("my-cluster"),
+						Project:            "my-proj",
+						Region:             "us-central1",
+						NetworkTier:        &standard,
+						LoadBalancer: &infrav1.LoadBalancerSpec{
+							APIServerAddress: pointer.String(tt.ref),
+						},
+					},
+					Status: infrav1.GCPClusterStatus{
+						FailureDomains: clusterv1.FailureDomains{
+							"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+			ctx := context.TODO()
+			addrKey := meta.RegionalKey("my-address", "us-central1")
+			if err := gce.Addresses().Insert(ctx, addrKey, &compute.Address{
+				Name:        "my-address",
+				Address:     "203.0.113.10",
+				AddressType: "EXTERNAL",
+				NetworkTier: "STANDARD",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			s := New(clusterScope)
+			s.addresses = gce.Addresses()
+			s.addresslister = &regionalAddresses{addresses: gce.Addresses(), region: "us-central1"}
+			s.backendservices = gce.RegionBackendServices()
+			s.forwardingrules = gce.ForwardingRules()
+			s.healthchecks = gce.RegionHealthChecks()
+			s.instancegroups = gce.InstanceGroups()
+			s.targettcpproxies = gce.BetaTargetTcpProxies()
+
+			err = s.Reconcile(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if _, err := gce.Addresses().Get(ctx, meta.RegionalKey("my-cluster-apiserver", "us-central1")); err == nil {
+				t.Errorf("Service.Reconcile() reserved an address for the cluster")
+			}
+			if host := clusterScope.ControlPlaneEndpoint().Host; host != "203.0.113.10" {
+				t.Errorf("ClusterScope.ControlPlaneEndpoint() host = %v, want 203.0.113.10", host)
+			}
+
+			if err := s.Delete(ctx); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+			if _, err := gce.Addresses().Get(ctx, addrKey); err != nil {
+				t.Errorf("Service.Delete() deleted the existing address: %v", err)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_Adoption(t *testing.T) {
+	standard := infrav1.NetworkTierStandard
+	tests := []struct {
+		name        string
+		healthcheck *compute.HealthCheck
+		wantErr     bool
+	}{
+		{
+			name: "matching healthcheck is adopted",
+			healthcheck: &compute.HealthCheck{
+				Name:           "my-cluster-apiserver",
+				Type:           "SSL",
+				SslHealthCheck: &compute.SSLHealthCheck{Port: 6443},
+			},
+		},
+		{
+			name: "healthcheck on another port isn't adopted",
+			healthcheck: &compute.HealthCheck{
+				Name:            "my-cluster-apiserver",
+				Type:            "HTTP",
+				HttpHealthCheck: &compute.HTTPHealthCheck{Port: 80},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+				},
+				GCPCluster: &infrav1.GCPCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+					Spec: infrav1.GCPClusterSpec{
+						ResourceNamePrefix: pointer.String("my-cluster"),
+						Project:            "my-proj",
+						Region:             "us-central1",
+						NetworkTier:        &standard,
+					},
+					Status: infrav1.GCPClusterStatus{
+						FailureDomains: clusterv1.FailureDomains{
+							"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+			ctx := context.TODO()
+			key := meta.RegionalKey("my-cluster-apiserver", "us-central1")
+			if err := gce.RegionHealthChecks().Insert(ctx, key, tt.healthcheck); err != nil {
+				t.Fatal(err)
+			}
+
+			s := New(clusterScope)
+			s.addresses = gce.Addresses()
+			s.backendservices = gce.RegionBackendServices()
+			s.forwardingrules = gce.ForwardingRules()
+			s.healthchecks = gce.RegionHealthChecks()
+			s.instancegroups = gce.InstanceGroups()
+			s.targettcpproxies = gce.BetaTargetTcpProxies()
+
+			err = s.Reconcile(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if _, err := gce.RegionBackendServices().Get(ctx, key); err == nil {
+					t.Errorf("Service.Reconcile() created a backendservice for a healthcheck that can't be adopted")
+				}
+				return
+			}
+
+			if _, err := gce.ForwardingRules().Get(ctx, key); err != nil {
+				t.Errorf("ForwardingRules.Get() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_ResourceNamePrefix(t *testing.T) {
+	gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	ctx := context.TODO()
+	names := make(map[string]bool)
+	for _, namespace := range []string{"team-a", "team-b"} {
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: namespace,
+				},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: &clusterv1.ClusterNetwork{},
+				},
+			},
+			GCPCluster: &infrav1.GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: namespace,
+				},
+				Spec: infrav1.GCPClusterSpec{
+					Project: "my-proj",
+					Region:  "us-central1",
+				},
+				Status: infrav1.GCPClusterStatus{
+					FailureDomains: clusterv1.FailureDomains{
+						"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		prefix := clusterScope.GCPCluster.Spec.ResourceNamePrefix
+		if prefix == nil || *prefix == "my-cluster" {
+			t.Fatalf("NewClusterScope() recorded resource name prefix = %v, want a namespace-aware prefix", prefix)
+		}
+
+		s := New(clusterScope)
+		s.addresses = gce.GlobalAddresses()
+		s.backendservices = gce.BackendServices()
+		s.forwardingrules = gce.GlobalForwardingRules()
+		s.healthchecks = gce.HealthChecks()
+		s.instancegroups = gce.InstanceGroups()
+		s.targettcpproxies = gce.BetaTargetTcpProxies()
+		s.securitypolicies = gce.BackendServices()
+		if err := s.Reconcile(ctx); err != nil {
+			t.Fatalf("Service.Reconcile() error = %v", err)
+		}
+
+		name := *prefix + "-apiserver"
+		if _, err := gce.GlobalForwardingRules().Get(ctx, meta.GlobalKey(name)); err != nil {
+			t.Errorf("GlobalForwardingRules.Get() error = %v", err)
+		}
+		names[name] = true
+	}
+
+	if len(names) != 2 {
+		t.Errorf("Service.Reconcile() forwarding rules = %v, want one per cluster", names)
+	}
+}
+
+func TestService_Reconcile_IPv6Adoption(t *testing.T) {
+	tests := []struct {
+		name           string
+		address        *compute.Address
+		forwardingrule *compute.ForwardingRule
+		wantErr        bool
+	}{
+		{
+			name:    "matching IPv6 address is adopted",
+			address: &compute.Address{Name: "my-cluster-apiserver-ipv6", AddressType: "EXTERNAL", IpVersion: "IPV6", Address: "2001:db8::1"},
+		},
+		{
+			name:    "IPv4 address isn't adopted",
+			address: &compute.Address{Name: "my-cluster-apiserver-ipv6", AddressType: "EXTERNAL", IpVersion: "IPV4", Address: "203.0.113.10"},
+			wantErr: true,
+		},
+		{
+			name:           "forwardingrule on another port isn't adopted",
+			address:        &compute.Address{Name: "my-cluster-apiserver-ipv6", AddressType: "EXTERNAL", IpVersion: "IPV6", Address: "2001:db8::1"},
+			forwardingrule: &compute.ForwardingRule{Name: "my-cluster-apiserver-ipv6", IPProtocol: "TCP", PortRange: "443-443", LoadBalancingScheme: "EXTERNAL", IPAddress: "2001:db8::1"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dualStack := infrav1.StackTypeIPv4IPv6
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+					Spec: clusterv1.ClusterSpec{
+						ClusterNetwork: &clusterv1.ClusterNetwork{},
+					},
+				},
+				GCPCluster: &infrav1.GCPCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cluster",
+						Namespace: "default",
+					},
+					Spec: infrav1.GCPClusterSpec{
+						ResourceNamePrefix: pointer.String("my-cluster"),
+						Project:            "my-proj",
+						Region:             "us-central1",
+						Network:            infrav1.NetworkSpec{APIServerStackType: &dualStack},
+					},
+					Status: infrav1.GCPClusterStatus{
+						FailureDomains: clusterv1.FailureDomains{
+							"us-central1-a": clusterv1.FailureDomainSpec{ControlPlane: true},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gce := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+			ctx := context.TODO()
+			key := meta.GlobalKey("my-cluster-apiserver-ipv6")
+			if err := gce.GlobalAddresses().Insert(ctx, key, tt.address); err != nil {
+				t.Fatal(err)
+			}
+			if tt.forwardingrule != nil {
+				if err := gce.GlobalForwardingRules().Insert(ctx, key, tt.forwardingrule); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			s := New(clusterScope)
+			s.addresses = gce.GlobalAddresses()
+			s.backendservices = gce.BackendServices()
+			s.forwardingrules = gce.GlobalForwardingRules()
+			s.healthchecks = gce.HealthChecks()
+			s.instancegroups = gce.InstanceGroups()
+			s.targettcpproxies = gce.BetaTargetTcpProxies()
+
+			err = s.Reconcile(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if tt.forwardingrule == nil {
+					if _, err := gce.GlobalForwardingRules().Get(ctx, key); err == nil {
+						t.Errorf("Service.Reconcile() created a forwardingrule for an address that can't be adopted")
+					}
+				}
+				if rule := clusterScope.Network().APIServerIPv6ForwardingRule; rule != nil {
+					t.Errorf("Service.Reconcile() IPv6 forwarding rule = %v, want none", *rule)
+				}
+				return
+			}
+
+			rule, err := gce.GlobalForwardingRules().Get(ctx, key)
+			if err != nil {
+				t.Fatalf("ForwardingRules.Get() error = %v", err)
+			}
+			if rule.IpVersion != "IPV6" {
+				t.Errorf("Service.Reconcile() IPv6 forwarding rule IP version = %v, want IPV6", rule.IpVersion)
+			}
+			if ip := clusterScope.Network().APIServerIPv6; ip == nil || *ip != tt.address.Address {
+				t.Errorf("Service.Reconcile() API server IPv6 = %v, want %v", ip, tt.address.Address)
+			}
+
+			if err := s.Delete(ctx); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+			if ip := clusterScope.Network().APIServerIPv6; ip != nil {
+				t.Errorf("Service.Delete() API server IPv6 = %v, want none", *ip)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	computebeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 )
 
@@ -33,6 +34,10 @@ type addressesInterface interface {
 	Delete(ctx context.Context, key *meta.Key) error
 }
 
+type addresslisterInterface interface {
+	List(ctx context.Context, fl *filter.F) ([]*compute.Address, error)
+}
+
 type backendservicesInterface interface {
 	Get(ctx context.Context, key *meta.Key) (*compute.BackendService, error)
 	Insert(ctx context.Context, key *meta.Key, obj *compute.BackendService) error
@@ -40,6 +45,10 @@ type backendservicesInterface interface {
 	Delete(ctx context.Context, key *meta.Key) error
 }
 
+type securitypoliciesInterface interface {
+	SetSecurityPolicy(context.Context, *meta.Key, *compute.SecurityPolicyReference) error
+}
+
 type forwardingrulesInterface interface {
 	Get(ctx context.Context, key *meta.Key) (*compute.ForwardingRule, error)
 	Insert(ctx context.Context, key *meta.Key, obj *compute.ForwardingRule) error
@@ -69,8 +78,15 @@ type targettcpproxiesInterface interface {
 type Scope interface {
 	cloud.Cluster
 	AddressSpec() *compute.Address
+	APIServerAddressRef() string
+	AddressIPv6Spec() *compute.Address
+	APIServerStackType() infrav1.StackType
+	APIServerDNSName() string
 	BackendServiceSpec() *compute.BackendService
+	BackendSpec(instanceGroup string) *compute.Backend
+	SecurityPolicySpec() *compute.SecurityPolicyReference
 	ForwardingRuleSpec() *compute.ForwardingRule
+	ForwardingRuleIPv6Spec() *compute.ForwardingRule
 	HealthCheckSpec() *compute.HealthCheck
 	InstanceGroupSpec(zone string) *compute.InstanceGroup
 	TargetTCPProxySpec() *computebeta.TargetTcpProxy
@@ -80,24 +96,68 @@ type Scope interface {
 type Service struct {
 	scope            Scope
 	addresses        addressesInterface
+	addresslister    addresslisterInterface
 	backendservices  backendservicesInterface
 	forwardingrules  forwardingrulesInterface
 	healthchecks     healthchecksInterface
 	instancegroups   instancegroupsInterface
 	targettcpproxies targettcpproxiesInterface
+	securitypolicies securitypoliciesInterface
 }
 
 var _ cloud.Reconciler = &Service{}
 
 // New returns Service from given scope.
 func New(scope Scope) *Service {
+	if scope.NetworkTier() == infrav1.NetworkTierStandard {
+		return &Service{
+			scope:            scope,
+			addresses:        scope.Cloud().Addresses(),
+			addresslister:    &regionalAddresses{addresses: scope.Cloud().Addresses(), region: scope.Region()},
+			backendservices:  scope.Cloud().RegionBackendServices(),
+			forwardingrules:  scope.Cloud().ForwardingRules(),
+			healthchecks:     scope.Cloud().RegionHealthChecks(),
+			instancegroups:   scope.Cloud().InstanceGroups(),
+			targettcpproxies: scope.Cloud().BetaTargetTcpProxies(),
+		}
+	}
+
 	return &Service{
 		scope:            scope,
 		addresses:        scope.Cloud().GlobalAddresses(),
+		addresslister:    scope.Cloud().GlobalAddresses(),
 		backendservices:  scope.Cloud().BackendServices(),
 		forwardingrules:  scope.Cloud().GlobalForwardingRules(),
 		healthchecks:     scope.Cloud().HealthChecks(),
 		instancegroups:   scope.Cloud().InstanceGroups(),
 		targettcpproxies: scope.Cloud().BetaTargetTcpProxies(), // This is temporary to use beta API.
+		securitypolicies: scope.Cloud().BackendServices(),
 	}
 }
+
+// regional reports whether the load balancer is a regional pass-through network load balancer,
+// as required by addresses of the Standard network tier.
+func (s *Service) regional() bool {
+	return s.scope.NetworkTier() == infrav1.NetworkTierStandard
+}
+
+// key returns the key of the regional or global load balancer components.
+func (s *Service) key(name string) *meta.Key {
+	if s.regional() {
+		return meta.RegionalKey(name, s.scope.Region())
+	}
+
+	return meta.GlobalKey(name)
+}
+
+// regionalAddresses adapts the regional addresses of the cloud to addresslisterInterface.
+type regionalAddresses struct {
+	addresses interface {
+		List(ctx context.Context, region string, fl *filter.F) ([]*compute.Address, error)
+	}
+	region string
+}
+
+func (a *regionalAddresses) List(ctx context.Context, fl *filter.F) ([]*compute.Address, error) {
+	return a.addresses.List(ctx, a.region, fl)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+const (
+	// ResourceNvidiaGPU is the extended resource name of NVIDIA GPUs, as advertised by the
+	// NVIDIA device plugin.
+	ResourceNvidiaGPU corev1.ResourceName = "nvidia.com/gpu"
+
+	// nvidiaAcceleratorPrefix is the prefix of the types of NVIDIA accelerators, e.g. "nvidia-tesla-a100".
+	nvidiaAcceleratorPrefix = "nvidia-"
+)
+
+// Reconcile resolves the machine type of the template and records its capacity.
+func (s *Service) Reconcile(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	log.Info("Reconciling machine template capacity")
+
+	capacity, err := s.capacity(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !equality.Semantic.DeepEqual(capacity, s.scope.Capacity()) {
+		log.V(2).Info("Updating machine template capacity", "capacity", capacity)
+		s.scope.SetCapacity(capacity)
+	}
+
+	return nil
+}
+
+// Delete is a no-op: the capacity of machine templates doesn't involve any GCP resource.
+func (s *Service) Delete(ctx context.Context) error {
+	return nil
+}
+
+// capacity returns the resources of the machines created from the template. Custom machine
+// types are resolved from their spec, other machine types with the MachineTypes API. NVIDIA
+// GPUs are those bundled with the machine type plus the guest accelerators of the template.
+func (s *Service) capacity(ctx context.Context) (corev1.ResourceList, error) {
+	capacity := corev1.ResourceList{}
+	if size := s.scope.RootDeviceSize(); size > 0 {
+		capacity[corev1.ResourceEphemeralStorage] = resource.MustParse(fmt.Sprintf("%dGi", size))
+	}
+
+	gpus := nvidiaGuestGPUs(s.scope.GuestAccelerators())
+	if custom := s.scope.CustomMachineType(); custom != nil {
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(custom.CPUs, resource.DecimalSI)
+		capacity[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", custom.MemoryMB))
+	} else {
+		zone := s.scope.Zone()
+		if zone == "" {
+			return nil, errors.New("failed to select the zone of the machine type: the cluster has no failure domains")
+		}
+
+		log := log.FromContext(ctx)
+		name := s.scope.MachineType()
+		log.V(2).Info("Looking for machine type", "name", name, "zone", zone)
+		machineType, err := s.machinetypes.Get(ctx, s.scope.Project(), zone, name)
+		if err != nil {
+			log.Error(err, "Error looking for machine type", "name", name, "zone", zone)
+			return nil, errors.Wrapf(err, "failed to get machine type %q in zone %q", name, zone)
+		}
+
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(machineType.GuestCpus, resource.DecimalSI)
+		capacity[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", machineType.MemoryMb))
+		gpus += nvidiaGPUs(machineType.Accelerators)
+	}
+
+	if gpus > 0 {
+		capacity[ResourceNvidiaGPU] = *resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+
+	return capacity, nil
+}
+
+// nvidiaGPUs returns the number of NVIDIA GPUs bundled with a machine type.
+func nvidiaGPUs(accelerators []*compute.MachineTypeAccelerators) int64 {
+	var gpus int64
+	for _, accelerator := range accelerators {
+		if strings.HasPrefix(accelerator.GuestAcceleratorType, nvidiaAcceleratorPrefix) {
+			gpus += accelerator.GuestAcceleratorCount
+		}
+	}
+
+	return gpus
+}
+
+// nvidiaGuestGPUs returns the number of NVIDIA GPUs attached to the instances as guest accelerators.
+func nvidiaGuestGPUs(accelerators []infrav1.GuestAccelerator) int64 {
+	var gpus int64
+	for _, accelerator := range accelerators {
+		if strings.HasPrefix(accelerator.Type, nvidiaAcceleratorPrefix) {
+			gpus += accelerator.Count
+		}
+	}
+
+	return gpus
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinetypes
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+type fakeMachineTypes struct {
+	project, zone, name string
+	machineTypes        map[string]*compute.MachineType
+}
+
+func (f *fakeMachineTypes) Get(_ context.Context, project, zone, name string) (*compute.MachineType, error) {
+	f.project, f.zone, f.name = project, zone, name
+	machineType, ok := f.machineTypes[name]
+	if !ok {
+		return nil, &googleapi.Error{Code: 404}
+	}
+
+	return machineType, nil
+}
+
+func newMachineTemplateScope(t *testing.T, spec infrav1.GCPMachineSpec) *scope.MachineTemplateScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fakec,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+			},
+			Status: infrav1.GCPClusterStatus{
+				FailureDomains: clusterv1.FailureDomains{
+					"us-central1-b": clusterv1.FailureDomainSpec{},
+					"us-central1-a": clusterv1.FailureDomainSpec{},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineTemplateScope, err := scope.NewMachineTemplateScope(scope.MachineTemplateScopeParams{
+		Client: fakec,
+		GCPMachineTemplate: &infrav1.GCPMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine-template",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPMachineTemplateSpec{
+				Template: infrav1.GCPMachineTemplateResource{
+					Spec: spec,
+				},
+			},
+		},
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineTemplateScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	machineTypes := map[string]*compute.MachineType{
+		"n1-standard-2": {Name: "n1-standard-2", GuestCpus: 2, MemoryMb: 7680},
+		"a2-highgpu-2g": {
+			Name:      "a2-highgpu-2g",
+			GuestCpus: 24,
+			MemoryMb:  174080,
+			Accelerators: []*compute.MachineTypeAccelerators{
+				{GuestAcceleratorType: "nvidia-tesla-a100", GuestAcceleratorCount: 2},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		spec     infrav1.GCPMachineSpec
+		wantZone string
+		want     corev1.ResourceList
+		wantErr  bool
+	}{
+		{
+			name:     "machine type resolved in the first failure domain of the cluster",
+			spec:     infrav1.GCPMachineSpec{InstanceType: "n1-standard-2"},
+			wantZone: "us-central1-a",
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("2"),
+				corev1.ResourceMemory:           resource.MustParse("7680Mi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("30Gi"),
+			},
+		},
+		{
+			name: "machine type with GPUs resolved in the failure domain of the template",
+			spec: infrav1.GCPMachineSpec{
+				InstanceType:   "a2-highgpu-2g",
+				FailureDomain:  pointer.String("us-central1-c"),
+				RootDeviceSize: 100,
+			},
+			wantZone: "us-central1-c",
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("24"),
+				corev1.ResourceMemory:           resource.MustParse("174080Mi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+				ResourceNvidiaGPU:               resource.MustParse("2"),
+			},
+		},
+		{
+			name: "machine type with attached GPUs",
+			spec: infrav1.GCPMachineSpec{
+				InstanceType: "n1-standard-2",
+				GuestAccelerators: []infrav1.GuestAccelerator{
+					{Type: "nvidia-tesla-t4", Count: 1},
+					{Type: "nvidia-tesla-p4", Count: 2},
+				},
+			},
+			wantZone: "us-central1-a",
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("2"),
+				corev1.ResourceMemory:           resource.MustParse("7680Mi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("30Gi"),
+				ResourceNvidiaGPU:               resource.MustParse("3"),
+			},
+		},
+		{
+			name: "machine type with attached accelerators other than NVIDIA GPUs",
+			spec: infrav1.GCPMachineSpec{
+				InstanceType:      "n1-standard-2",
+				GuestAccelerators: []infrav1.GuestAccelerator{{Type: "ct5lp", Count: 1}},
+			},
+			wantZone: "us-central1-a",
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("2"),
+				corev1.ResourceMemory:           resource.MustParse("7680Mi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("30Gi"),
+			},
+		},
+		{
+			name: "custom machine type with attached GPUs",
+			spec: infrav1.GCPMachineSpec{
+				CustomMachineType: &infrav1.CustomMachineType{CPUs: 4, MemoryMB: 8192},
+				GuestAccelerators: []infrav1.GuestAccelerator{{Type: "nvidia-tesla-t4", Count: 1}},
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("30Gi"),
+				ResourceNvidiaGPU:               resource.MustParse("1"),
+			},
+		},
+		{
+			name: "custom machine type (should not look for the machine type)",
+			spec: infrav1.GCPMachineSpec{
+				CustomMachineType: &infrav1.CustomMachineType{CPUs: 4, MemoryMB: 8192},
+			},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("30Gi"),
+			},
+		},
+		{
+			name:     "unknown machine type",
+			spec:     infrav1.GCPMachineSpec{InstanceType: "n1-unknown-2"},
+			wantZone: "us-central1-a",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machineTemplateScope := newMachineTemplateScope(t, tt.spec)
+			fakeMachineTypes := &fakeMachineTypes{machineTypes: machineTypes}
+			s := &Service{
+				scope:        machineTemplateScope,
+				machinetypes: fakeMachineTypes,
+			}
+			err := s.Reconcile(context.TODO())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if fakeMachineTypes.zone != tt.wantZone {
+				t.Errorf("Service.Reconcile() zone = %v, want %v", fakeMachineTypes.zone, tt.wantZone)
+			}
+
+			if got := machineTemplateScope.Capacity(); !equality.Semantic.DeepEqual(got, tt.want) {
+				t.Errorf("Service.Reconcile() capacity = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
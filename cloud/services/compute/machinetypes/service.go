@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinetypes
+
+import (
+	"context"
+
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type machinetypesInterface interface {
+	Get(ctx context.Context, project, zone, name string) (*compute.MachineType, error)
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	ComputeClient() *compute.Service
+	Project() string
+	Zone() string
+	MachineType() string
+	CustomMachineType() *infrav1.CustomMachineType
+	GuestAccelerators() []infrav1.GuestAccelerator
+	RootDeviceSize() int64
+	Capacity() corev1.ResourceList
+	SetCapacity(capacity corev1.ResourceList)
+}
+
+// Service implements machine template capacity reconciler.
+type Service struct {
+	scope        Scope
+	machinetypes machinetypesInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:        scope,
+		machinetypes: &machinetypes{svc: scope.ComputeClient()},
+	}
+}
+
+// machinetypes adapts the compute client to machinetypesInterface.
+type machinetypes struct {
+	svc *compute.Service
+}
+
+func (m *machinetypes) Get(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+	return m.svc.MachineTypes.Get(project, zone, name).Context(ctx).Do()
+}
@@ -23,7 +23,7 @@ import (
 	"google.golang.org/api/compute/v1"
 
 	"k8s.io/utils/pointer"
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -37,7 +37,11 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return err
 	}
 
-	if network.Description == infrav1.ClusterTagKey(s.scope.Name()) {
+	if network.Description == infrav1.ClusterTagKey(s.scope.ResourceNamePrefix()) {
+		if err := s.createOrGetSubnetworks(ctx, network); err != nil {
+			return err
+		}
+
 		router, err := s.createOrGetRouter(ctx, network)
 		if err != nil {
 			return err
@@ -61,7 +65,7 @@ func (s *Service) Delete(ctx context.Context) error {
 		return gcperrors.IgnoreNotFound(err)
 	}
 
-	if network.Description != infrav1.ClusterTagKey(s.scope.Name()) {
+	if network.Description != infrav1.ClusterTagKey(s.scope.ResourceNamePrefix()) {
 		return nil
 	}
 
@@ -75,12 +79,20 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
-	if router != nil && router.Description == infrav1.ClusterTagKey(s.scope.Name()) {
+	if router != nil && router.Description == infrav1.ClusterTagKey(s.scope.ResourceNamePrefix()) {
 		if err := s.routers.Delete(ctx, routerKey); err != nil && !gcperrors.IsNotFound(err) {
 			return err
 		}
 	}
 
+	for _, spec := range s.scope.SubnetworksSpec() {
+		log.V(2).Info("Deleting a subnetwork", "name", spec.Name)
+		if err := s.subnetworks.Delete(ctx, meta.RegionalKey(spec.Name, spec.Region)); err != nil && !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error deleting a subnetwork", "name", spec.Name)
+			return err
+		}
+	}
+
 	if err := s.networks.Delete(ctx, networkKey); err != nil {
 		log.Error(err, "Error deleting a network", "name", s.scope.NetworkName())
 		return err
@@ -132,7 +144,7 @@ func (s *Service) createOrGetRouter(ctx context.Context, network *compute.Networ
 		}
 
 		spec.Network = network.SelfLink
-		spec.Description = infrav1.ClusterTagKey(s.scope.Name())
+		spec.Description = infrav1.ClusterTagKey(s.scope.ResourceNamePrefix())
 		log.V(2).Info("Creating a cloudnat router", "name", spec.Name)
 		if err := s.routers.Insert(ctx, routerKey, spec); err != nil {
 			log.Error(err, "Error creating a cloudnat router", "name", spec.Name)
@@ -147,3 +159,27 @@ func (s *Service) createOrGetRouter(ctx context.Context, network *compute.Networ
 
 	return router, nil
 }
+
+// createOrGetSubnetworks creates the subnetworks of the network if not exist.
+func (s *Service) createOrGetSubnetworks(ctx context.Context, network *compute.Network) error {
+	log := log.FromContext(ctx)
+	for _, spec := range s.scope.SubnetworksSpec() {
+		log.V(2).Info("Looking for subnetwork", "name", spec.Name)
+		key := meta.RegionalKey(spec.Name, spec.Region)
+		if _, err := s.subnetworks.Get(ctx, key); err != nil {
+			if !gcperrors.IsNotFound(err) {
+				log.Error(err, "Error looking for subnetwork", "name", spec.Name)
+				return err
+			}
+
+			spec.Network = network.SelfLink
+			log.V(2).Info("Creating a subnetwork", "name", spec.Name)
+			if err := s.subnetworks.Insert(ctx, key, spec); err != nil {
+				log.Error(err, "Error creating a subnetwork", "name", spec.Name)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networks
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/compute/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+func TestService_Reconcile_DualStackSubnetworks(t *testing.T) {
+	dualStack := infrav1.StackTypeIPv4IPv6
+	external := infrav1.IPv6AccessTypeExternal
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				Network: infrav1.NetworkSpec{
+					Name:                  pointer.String("my-network"),
+					AutoCreateSubnetworks: pointer.Bool(false),
+					Subnets: infrav1.Subnets{
+						{
+							Name:      "nodes",
+							CidrBlock: "10.0.0.0/20",
+							SecondaryCidrBlocks: map[string]string{
+								"services": "10.2.0.0/20",
+								"pods":     "10.1.0.0/16",
+							},
+							StackType:      &dualStack,
+							IPv6AccessType: &external,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	s := New(clusterScope)
+	s.networks = mock.Networks()
+	s.routers = mock.Routers()
+	s.subnetworks = mock.Subnetworks()
+
+	ctx := context.TODO()
+	if err := s.Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	key := meta.RegionalKey("nodes", "us-central1")
+	got, err := mock.Subnetworks().Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Subnetworks.Get() error = %v", err)
+	}
+
+	want := &compute.Subnetwork{
+		Name:           "nodes",
+		Region:         "us-central1",
+		IpCidrRange:    "10.0.0.0/20",
+		Description:    infrav1.ClusterTagKey(clusterScope.ResourceNamePrefix()),
+		Network:        *clusterScope.Network().SelfLink,
+		StackType:      "IPV4_IPV6",
+		Ipv6AccessType: "EXTERNAL",
+		SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{
+			{RangeName: "pods", IpCidrRange: "10.1.0.0/16"},
+			{RangeName: "services", IpCidrRange: "10.2.0.0/20"},
+		},
+		SelfLink: got.SelfLink,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Service.Reconcile() subnetwork = %+v, want %+v", got, want)
+	}
+
+	if err := s.Delete(ctx); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if _, err := mock.Subnetworks().Get(ctx, key); err == nil {
+		t.Errorf("Service.Delete() did not delete the subnetwork")
+	}
+}
+
+func TestService_Delete_SameNameClusters(t *testing.T) {
+	mock := cloud.NewMockGCE(&cloud.SingleProjectRouter{ID: "my-proj"})
+	ctx := context.TODO()
+	services := make(map[string]*Service)
+	for _, namespace := range []string{"team-a", "team-b"} {
+		clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: namespace,
+				},
+			},
+			GCPCluster: &infrav1.GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: namespace,
+				},
+				Spec: infrav1.GCPClusterSpec{
+					Project: "my-proj",
+					Region:  "us-central1",
+					Network: infrav1.NetworkSpec{
+						Name: pointer.String("my-network"),
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := New(clusterScope)
+		s.networks = mock.Networks()
+		s.routers = mock.Routers()
+		s.subnetworks = mock.Subnetworks()
+		services[namespace] = s
+	}
+
+	if err := services["team-a"].Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	// The cluster of the same name in another namespace uses the network without owning it.
+	if err := services["team-b"].Reconcile(ctx); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+	if router := services["team-b"].scope.Network().Router; router != nil {
+		t.Errorf("Service.Reconcile() router = %v, want nil for a network owned by another cluster", *router)
+	}
+
+	if err := services["team-b"].Delete(ctx); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if _, err := mock.Networks().Get(ctx, meta.GlobalKey("my-network")); err != nil {
+		t.Errorf("Service.Delete() deleted the network owned by another cluster: %v", err)
+	}
+
+	if err := services["team-a"].Delete(ctx); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if _, err := mock.Networks().Get(ctx, meta.GlobalKey("my-network")); err == nil {
+		t.Errorf("Service.Delete() did not delete the network")
+	}
+}
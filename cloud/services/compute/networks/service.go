@@ -37,18 +37,26 @@ type routersInterface interface {
 	Delete(ctx context.Context, key *meta.Key) error
 }
 
+type subnetworksInterface interface {
+	Get(ctx context.Context, key *meta.Key) (*compute.Subnetwork, error)
+	Insert(ctx context.Context, key *meta.Key, obj *compute.Subnetwork) error
+	Delete(ctx context.Context, key *meta.Key) error
+}
+
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	cloud.Cluster
 	NetworkSpec() *compute.Network
 	NatRouterSpec() *compute.Router
+	SubnetworksSpec() []*compute.Subnetwork
 }
 
 // Service implements networks reconciler.
 type Service struct {
-	scope    Scope
-	networks networksInterface
-	routers  routersInterface
+	scope       Scope
+	networks    networksInterface
+	routers     routersInterface
+	subnetworks subnetworksInterface
 }
 
 var _ cloud.Reconciler = &Service{}
@@ -56,8 +64,9 @@ var _ cloud.Reconciler = &Service{}
 // New returns Service from given scope.
 func New(scope Scope) *Service {
 	return &Service{
-		scope:    scope,
-		networks: scope.Cloud().Networks(),
-		routers:  scope.Cloud().Routers(),
+		scope:       scope,
+		networks:    scope.Cloud().Networks(),
+		routers:     scope.Cloud().Routers(),
+		subnetworks: scope.Cloud().Subnetworks(),
 	}
 }
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+// Reconcile reconcile cluster resource policies components.
+func (s *Service) Reconcile(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	log.Info("Reconciling resource policies resources")
+	for _, spec := range s.scope.ResourcePoliciesSpec() {
+		log.V(2).Info("Looking for resource policy", "name", spec.Name)
+		if _, err := s.resourcepolicies.Get(ctx, s.scope.Project(), s.scope.Region(), spec.Name); err != nil {
+			if !gcperrors.IsNotFound(err) {
+				log.Error(err, "Error looking for resource policy", "name", spec.Name)
+				return err
+			}
+
+			log.V(2).Info("Creating resource policy", "name", spec.Name)
+			if err := s.resourcepolicies.Insert(ctx, s.scope.Project(), s.scope.Region(), spec); err != nil {
+				log.Error(err, "Error creating resource policy", "name", spec.Name)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete delete cluster resource policies components.
+func (s *Service) Delete(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	log.Info("Deleting resource policies resources")
+	for _, spec := range s.scope.ResourcePoliciesSpec() {
+		log.V(2).Info("Deleting resource policy", "name", spec.Name)
+		if err := s.resourcepolicies.Delete(ctx, s.scope.Project(), s.scope.Region(), spec.Name); err != nil {
+			if !gcperrors.IsNotFound(err) {
+				log.Error(err, "Error deleting resource policy", "name", spec.Name)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+type fakeResourcePolicies struct {
+	policies map[string]*compute.ResourcePolicy
+	inserted []string
+}
+
+func (f *fakeResourcePolicies) Get(_ context.Context, _, _, name string) (*compute.ResourcePolicy, error) {
+	if p, ok := f.policies[name]; ok {
+		return p, nil
+	}
+
+	return nil, &googleapi.Error{Code: http.StatusNotFound}
+}
+
+func (f *fakeResourcePolicies) Insert(_ context.Context, _, _ string, policy *compute.ResourcePolicy) error {
+	f.policies[policy.Name] = policy
+	f.inserted = append(f.inserted, policy.Name)
+	return nil
+}
+
+func (f *fakeResourcePolicies) Delete(_ context.Context, _, _, name string) error {
+	if _, ok := f.policies[name]; !ok {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	delete(f.policies, name)
+	return nil
+}
+
+func newClusterScope(t *testing.T) *scope.ClusterScope {
+	t.Helper()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+				PlacementPolicies: []infrav1.PlacementPolicySpec{
+					{
+						Name:                    "spread",
+						Collocation:             infrav1.PlacementPolicyCollocationSpread,
+						AvailabilityDomainCount: pointer.Int64Ptr(3),
+					},
+					{
+						Name:        "compact",
+						Collocation: infrav1.PlacementPolicyCollocationCompact,
+						VMCount:     pointer.Int64Ptr(2),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return clusterScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	clusterScope := newClusterScope(t)
+	fake := &fakeResourcePolicies{
+		policies: map[string]*compute.ResourcePolicy{
+			"my-cluster-spread": {Name: "my-cluster-spread"},
+		},
+	}
+	s := &Service{scope: clusterScope, resourcepolicies: fake}
+
+	if err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	if len(fake.inserted) != 1 || fake.inserted[0] != "my-cluster-compact" {
+		t.Fatalf("Reconcile() inserted = %v, want [my-cluster-compact]", fake.inserted)
+	}
+
+	got := fake.policies["my-cluster-compact"].GroupPlacementPolicy
+	if got == nil || got.Collocation != "COLLOCATED" || got.VmCount != 2 {
+		t.Errorf("Reconcile() group placement policy = %+v, want COLLOCATED with 2 VMs", got)
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	clusterScope := newClusterScope(t)
+	fake := &fakeResourcePolicies{
+		policies: map[string]*compute.ResourcePolicy{
+			"my-cluster-spread": {Name: "my-cluster-spread"},
+		},
+	}
+	s := &Service{scope: clusterScope, resourcepolicies: fake}
+
+	if err := s.Delete(context.TODO()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if len(fake.policies) != 0 {
+		t.Errorf("Delete() left policies %v", fake.policies)
+	}
+}
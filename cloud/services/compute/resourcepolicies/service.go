@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicies
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type resourcepoliciesInterface interface {
+	Get(ctx context.Context, project, region, name string) (*compute.ResourcePolicy, error)
+	Insert(ctx context.Context, project, region string, policy *compute.ResourcePolicy) error
+	Delete(ctx context.Context, project, region, name string) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Cluster
+	ResourcePoliciesSpec() []*compute.ResourcePolicy
+}
+
+// Service implements resource policies reconciler.
+type Service struct {
+	scope            Scope
+	resourcepolicies resourcepoliciesInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:            scope,
+		resourcepolicies: &resourcepolicies{svc: scope.ComputeClient()},
+	}
+}
+
+// resourcepolicies adapts the compute client to resourcepoliciesInterface,
+// waiting for the completion of the operations.
+type resourcepolicies struct {
+	svc *compute.Service
+}
+
+func (r *resourcepolicies) Get(ctx context.Context, project, region, name string) (*compute.ResourcePolicy, error) {
+	return r.svc.ResourcePolicies.Get(project, region, name).Context(ctx).Do()
+}
+
+func (r *resourcepolicies) Insert(ctx context.Context, project, region string, policy *compute.ResourcePolicy) error {
+	op, err := r.svc.ResourcePolicies.Insert(project, region, policy).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return r.wait(ctx, project, region, op)
+}
+
+func (r *resourcepolicies) Delete(ctx context.Context, project, region, name string) error {
+	op, err := r.svc.ResourcePolicies.Delete(project, region, name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return r.wait(ctx, project, region, op)
+}
+
+func (r *resourcepolicies) wait(ctx context.Context, project, region string, op *compute.Operation) error {
+	for op.Status != "DONE" {
+		var err error
+		op, err = r.svc.RegionOperations.Wait(project, region, op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return errors.Errorf("operation %s failed: %s", op.Name, op.Error.Errors[0].Message)
+	}
+
+	return nil
+}
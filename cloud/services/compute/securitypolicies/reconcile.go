@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicies
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+// Reconcile reconcile the security policy enforcing the control plane access, keeping its rules
+// in sync with the allowed source ranges. A policy no longer needed is deleted with the cluster,
+// once the load balancer detached it.
+func (s *Service) Reconcile(ctx context.Context) error {
+	spec := s.scope.APIServerSecurityPolicySpec()
+	if spec == nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Reconciling security policy resources")
+	log.V(2).Info("Looking for security policy", "name", spec.Name)
+	policy, err := s.securitypolicies.Get(ctx, meta.GlobalKey(spec.Name))
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for security policy", "name", spec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating a security policy", "name", spec.Name)
+		if err := s.securitypolicies.Insert(ctx, meta.GlobalKey(spec.Name), spec); err != nil {
+			log.Error(err, "Error creating a security policy", "name", spec.Name)
+			return err
+		}
+
+		return nil
+	}
+
+	return s.syncRules(ctx, policy, spec)
+}
+
+// Delete delete the security policy enforcing the control plane access.
+func (s *Service) Delete(ctx context.Context) error {
+	// Security policies are only attached to global load balancers.
+	if s.scope.NetworkTier() == infrav1.NetworkTierStandard {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Deleting security policy resources")
+	name := s.scope.APIServerSecurityPolicyName()
+	log.V(2).Info("Deleting security policy", "name", name)
+	if err := gcperrors.IgnoreNotFound(s.securitypolicies.Delete(ctx, meta.GlobalKey(name))); err != nil {
+		log.Error(err, "Error deleting security policy", "name", name)
+		return err
+	}
+
+	return nil
+}
+
+// syncRules adds, patches and removes the rules of the policy to match its spec.
+func (s *Service) syncRules(ctx context.Context, policy, spec *computebeta.SecurityPolicy) error {
+	log := log.FromContext(ctx)
+	rules := make(map[int64]*computebeta.SecurityPolicyRule, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		rules[rule.Priority] = rule
+	}
+
+	for _, want := range spec.Rules {
+		got, ok := rules[want.Priority]
+		delete(rules, want.Priority)
+		switch {
+		case !ok:
+			log.V(2).Info("Adding a security policy rule", "name", spec.Name, "priority", want.Priority)
+			if err := s.securitypolicies.AddRule(ctx, meta.GlobalKey(spec.Name), want); err != nil {
+				log.Error(err, "Error adding a security policy rule", "name", spec.Name, "priority", want.Priority)
+				return err
+			}
+		case !ruleMatches(got, want):
+			log.V(2).Info("Patching a security policy rule", "name", spec.Name, "priority", want.Priority)
+			if err := s.securitypolicies.PatchRule(ctx, meta.GlobalKey(spec.Name), want); err != nil {
+				log.Error(err, "Error patching a security policy rule", "name", spec.Name, "priority", want.Priority)
+				return err
+			}
+		}
+	}
+
+	for priority := range rules {
+		log.V(2).Info("Removing a security policy rule", "name", spec.Name, "priority", priority)
+		if err := s.securitypolicies.RemoveRule(ctx, meta.GlobalKey(spec.Name), priority); err != nil {
+			log.Error(err, "Error removing a security policy rule", "name", spec.Name, "priority", priority)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ruleMatches reports whether a rule has the action and source ranges of its spec.
+func ruleMatches(rule, spec *computebeta.SecurityPolicyRule) bool {
+	if rule.Action != spec.Action || rule.Match == nil || rule.Match.Config == nil {
+		return false
+	}
+
+	return reflect.DeepEqual(rule.Match.Config.SrcIpRanges, spec.Match.Config.SrcIpRanges)
+}
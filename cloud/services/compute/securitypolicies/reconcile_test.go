@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/googleapi"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+type fakeSecurityPolicies struct {
+	policies map[string]*computebeta.SecurityPolicy
+	calls    []string
+}
+
+func (f *fakeSecurityPolicies) Get(_ context.Context, key *meta.Key) (*computebeta.SecurityPolicy, error) {
+	if p, ok := f.policies[key.Name]; ok {
+		return p, nil
+	}
+
+	return nil, &googleapi.Error{Code: http.StatusNotFound}
+}
+
+func (f *fakeSecurityPolicies) Insert(_ context.Context, _ *meta.Key, policy *computebeta.SecurityPolicy) error {
+	f.calls = append(f.calls, "insert")
+	f.policies[policy.Name] = policy
+	return nil
+}
+
+func (f *fakeSecurityPolicies) Delete(_ context.Context, key *meta.Key) error {
+	if _, ok := f.policies[key.Name]; !ok {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	f.calls = append(f.calls, "delete")
+	delete(f.policies, key.Name)
+	return nil
+}
+
+func (f *fakeSecurityPolicies) AddRule(_ context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error {
+	f.calls = append(f.calls, fmt.Sprintf("add %d", rule.Priority))
+	f.policies[key.Name].Rules = append(f.policies[key.Name].Rules, rule)
+	return nil
+}
+
+func (f *fakeSecurityPolicies) PatchRule(_ context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error {
+	f.calls = append(f.calls, fmt.Sprintf("patch %d", rule.Priority))
+	for i, r := range f.policies[key.Name].Rules {
+		if r.Priority == rule.Priority {
+			f.policies[key.Name].Rules[i] = rule
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeSecurityPolicies) RemoveRule(_ context.Context, key *meta.Key, priority int64) error {
+	f.calls = append(f.calls, fmt.Sprintf("remove %d", priority))
+	policy := f.policies[key.Name]
+	for i, r := range policy.Rules {
+		if r.Priority == priority {
+			policy.Rules = append(policy.Rules[:i], policy.Rules[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func newClusterScope(t *testing.T, tier infrav1.NetworkTier, cidrs ...string) *scope.ClusterScope {
+	t.Helper()
+
+	gcpCluster := &infrav1.GCPCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: infrav1.GCPClusterSpec{
+			ResourceNamePrefix: pointer.String("my-cluster"),
+			Project:            "my-proj",
+			Region:             "us-central1",
+			NetworkTier:        &tier,
+		},
+	}
+	if len(cidrs) > 0 {
+		gcpCluster.Spec.ControlPlaneAccess = &infrav1.ControlPlaneAccessSpec{AllowedCIDRs: cidrs}
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: gcpCluster,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return clusterScope
+}
+
+// cidrs returns n distinct CIDR ranges.
+func cidrs(n int) []string {
+	res := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, fmt.Sprintf("203.0.113.%d/32", i))
+	}
+
+	return res
+}
+
+// srcIPRanges returns the source ranges of the rules of a policy, by priority.
+func srcIPRanges(policy *computebeta.SecurityPolicy) map[int64][]string {
+	res := make(map[int64][]string, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		res[rule.Priority] = rule.Match.Config.SrcIpRanges
+	}
+
+	return res
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name      string
+		tier      infrav1.NetworkTier
+		cidrs     []string
+		existing  *computebeta.SecurityPolicy
+		wantRules map[int64][]string
+		wantCalls []string
+	}{
+		{
+			name:      "no control plane access",
+			tier:      infrav1.NetworkTierPremium,
+			wantCalls: nil,
+		},
+		{
+			name:      "control plane access enforced by the firewall",
+			tier:      infrav1.NetworkTierStandard,
+			cidrs:     cidrs(2),
+			wantCalls: nil,
+		},
+		{
+			name:  "creates a policy with one rule per 10 ranges",
+			tier:  infrav1.NetworkTierPremium,
+			cidrs: cidrs(12),
+			wantRules: map[int64][]string{
+				1000:       cidrs(10),
+				1001:       cidrs(12)[10:],
+				2147483647: {"*"},
+			},
+			wantCalls: []string{"insert"},
+		},
+		{
+			name:  "syncs the rules of an existing policy",
+			tier:  infrav1.NetworkTierPremium,
+			cidrs: cidrs(3),
+			existing: &computebeta.SecurityPolicy{
+				Name: "my-cluster-apiserver",
+				Rules: []*computebeta.SecurityPolicyRule{
+					{Action: "allow", Priority: 1000, Match: &computebeta.SecurityPolicyRuleMatcher{Config: &computebeta.SecurityPolicyRuleMatcherConfig{SrcIpRanges: cidrs(10)}}},
+					{Action: "allow", Priority: 1001, Match: &computebeta.SecurityPolicyRuleMatcher{Config: &computebeta.SecurityPolicyRuleMatcherConfig{SrcIpRanges: cidrs(12)[10:]}}},
+					{Action: "allow", Priority: 2147483647, Match: &computebeta.SecurityPolicyRuleMatcher{Config: &computebeta.SecurityPolicyRuleMatcherConfig{SrcIpRanges: []string{"*"}}}},
+				},
+			},
+			wantRules: map[int64][]string{
+				1000:       cidrs(3),
+				2147483647: {"*"},
+			},
+			wantCalls: []string{"patch 1000", "patch 2147483647", "remove 1001"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeSecurityPolicies{policies: map[string]*computebeta.SecurityPolicy{}}
+			if tt.existing != nil {
+				fake.policies[tt.existing.Name] = tt.existing
+			}
+			s := &Service{scope: newClusterScope(t, tt.tier, tt.cidrs...), securitypolicies: fake}
+
+			if err := s.Reconcile(context.TODO()); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			sort.Strings(fake.calls)
+			if !reflect.DeepEqual(fake.calls, tt.wantCalls) {
+				t.Errorf("Service.Reconcile() calls = %v, want %v", fake.calls, tt.wantCalls)
+			}
+
+			if tt.wantRules == nil {
+				return
+			}
+
+			policy, ok := fake.policies["my-cluster-apiserver"]
+			if !ok {
+				t.Fatalf("Service.Reconcile() did not create the security policy")
+			}
+			if got := srcIPRanges(policy); !reflect.DeepEqual(got, tt.wantRules) {
+				t.Errorf("Service.Reconcile() rules = %v, want %v", got, tt.wantRules)
+			}
+			for _, rule := range policy.Rules {
+				if rule.Priority == 2147483647 && rule.Action != "deny(403)" {
+					t.Errorf("Service.Reconcile() default rule action = %v, want deny(403)", rule.Action)
+				}
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	fake := &fakeSecurityPolicies{policies: map[string]*computebeta.SecurityPolicy{
+		"my-cluster-apiserver": {Name: "my-cluster-apiserver"},
+	}}
+	// The policy is deleted even when the control plane access was removed from the spec.
+	s := &Service{scope: newClusterScope(t, infrav1.NetworkTierPremium), securitypolicies: fake}
+
+	if err := s.Delete(context.TODO()); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if _, ok := fake.policies["my-cluster-apiserver"]; ok {
+		t.Errorf("Service.Delete() did not delete the security policy")
+	}
+
+	if err := s.Delete(context.TODO()); err != nil {
+		t.Errorf("Service.Delete() error = %v, want nil for a missing policy", err)
+	}
+}
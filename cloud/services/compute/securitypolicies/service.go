@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicies
+
+import (
+	"context"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	computebeta "google.golang.org/api/compute/v0.beta"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type securitypoliciesInterface interface {
+	Get(ctx context.Context, key *meta.Key) (*computebeta.SecurityPolicy, error)
+	Insert(ctx context.Context, key *meta.Key, obj *computebeta.SecurityPolicy) error
+	Delete(ctx context.Context, key *meta.Key) error
+	AddRule(ctx context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error
+	PatchRule(ctx context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error
+	RemoveRule(ctx context.Context, key *meta.Key, priority int64) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Cluster
+	CloudService() *cloud.CloudService
+	APIServerSecurityPolicyName() string
+	APIServerSecurityPolicySpec() *computebeta.SecurityPolicy
+}
+
+// Service implements security policies reconciler.
+type Service struct {
+	scope            Scope
+	securitypolicies securitypoliciesInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope: scope,
+		securitypolicies: &securitypolicies{
+			Cloud: scope.Cloud(),
+			svc:   scope.CloudService(),
+		},
+	}
+}
+
+// securitypolicies adapts the cloud to securitypoliciesInterface. The cloud can't select the rule
+// to patch or remove, so these calls are made with the compute client of the cloud service and
+// its rate limiter.
+type securitypolicies struct {
+	cloud.Cloud
+	svc *cloud.CloudService
+}
+
+func (p *securitypolicies) Get(ctx context.Context, key *meta.Key) (*computebeta.SecurityPolicy, error) {
+	return p.BetaSecurityPolicies().Get(ctx, key)
+}
+
+func (p *securitypolicies) Insert(ctx context.Context, key *meta.Key, obj *computebeta.SecurityPolicy) error {
+	return p.BetaSecurityPolicies().Insert(ctx, key, obj)
+}
+
+func (p *securitypolicies) Delete(ctx context.Context, key *meta.Key) error {
+	return p.BetaSecurityPolicies().Delete(ctx, key)
+}
+
+func (p *securitypolicies) AddRule(ctx context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error {
+	return p.BetaSecurityPolicies().AddRule(ctx, key, rule)
+}
+
+func (p *securitypolicies) PatchRule(ctx context.Context, key *meta.Key, rule *computebeta.SecurityPolicyRule) error {
+	project, err := p.accept(ctx, "PatchRule")
+	if err != nil {
+		return err
+	}
+
+	op, err := p.svc.Beta.SecurityPolicies.PatchRule(project, key.Name, rule).Priority(rule.Priority).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return p.svc.WaitForCompletion(ctx, op)
+}
+
+func (p *securitypolicies) RemoveRule(ctx context.Context, key *meta.Key, priority int64) error {
+	project, err := p.accept(ctx, "RemoveRule")
+	if err != nil {
+		return err
+	}
+
+	op, err := p.svc.Beta.SecurityPolicies.RemoveRule(project, key.Name).Priority(priority).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return p.svc.WaitForCompletion(ctx, op)
+}
+
+// accept blocks until the given operation can be performed, and returns the project of the call.
+func (p *securitypolicies) accept(ctx context.Context, operation string) (string, error) {
+	project := p.svc.ProjectRouter.ProjectID(ctx, meta.VersionBeta, "SecurityPolicies")
+	return project, p.svc.RateLimiter.Accept(ctx, &cloud.RateLimitKey{
+		ProjectID: project,
+		Operation: operation,
+		Version:   meta.VersionBeta,
+		Service:   "SecurityPolicies",
+	})
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/dns/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+// Reconcile reconcile the managed zone and the DNS records of the control plane endpoint.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if s.scope.DNS() == nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Reconciling dns resources")
+	if err := s.createOrGetManagedZone(ctx); err != nil {
+		return err
+	}
+
+	for _, spec := range s.scope.APIServerRecordSetSpecs() {
+		if err := s.createOrUpdateRecordSet(ctx, spec); err != nil {
+			return err
+		}
+	}
+
+	endpoint := s.scope.ControlPlaneEndpoint()
+	endpoint.Host = s.scope.APIServerDNSName()
+	s.scope.SetControlPlaneEndpoint(endpoint)
+	return nil
+}
+
+// Delete delete the DNS records of the control plane endpoint, and the managed zone if it's owned by the cluster.
+func (s *Service) Delete(ctx context.Context) error {
+	if s.scope.DNS() == nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Deleting dns resources")
+	project, zone := s.scope.Project(), s.scope.ManagedZoneName()
+	for _, spec := range s.scope.APIServerRecordSetSpecs() {
+		if err := s.deleteRecordSet(ctx, spec); err != nil {
+			return err
+		}
+	}
+
+	// Managed zones referenced by the spec are never deleted.
+	if s.scope.DNS().ManagedZone != nil {
+		return nil
+	}
+
+	log.V(2).Info("Deleting managed zone", "name", zone)
+	if err := gcperrors.IgnoreNotFound(s.managedzones.Delete(ctx, project, zone)); err != nil {
+		log.Error(err, "Error deleting managed zone", "name", zone)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) createOrGetManagedZone(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	spec := s.scope.ManagedZoneSpec()
+	log.V(2).Info("Looking for managed zone", "name", spec.Name)
+	zone, err := s.managedzones.Get(ctx, s.scope.Project(), spec.Name)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for managed zone", "name", spec.Name)
+			return err
+		}
+
+		if s.scope.DNS().ManagedZone != nil {
+			return errors.Wrapf(err, "managed zone %q not found", spec.Name)
+		}
+
+		log.V(2).Info("Creating a managed zone", "name", spec.Name)
+		if err := s.managedzones.Create(ctx, s.scope.Project(), spec); err != nil {
+			log.Error(err, "Error creating a managed zone", "name", spec.Name)
+			return err
+		}
+
+		return nil
+	}
+
+	if zone.DnsName != spec.DnsName {
+		return errors.Errorf("managed zone %q serves %q instead of %q", spec.Name, zone.DnsName, spec.DnsName)
+	}
+
+	return nil
+}
+
+func (s *Service) createOrUpdateRecordSet(ctx context.Context, spec *dns.ResourceRecordSet) error {
+	log := log.FromContext(ctx)
+	project, zone := s.scope.Project(), s.scope.ManagedZoneName()
+	if len(spec.Rrdatas) == 0 {
+		return errors.Errorf("the address of the API server load balancer for the %s record hasn't been reserved yet", spec.Type)
+	}
+
+	log.V(2).Info("Looking for record set", "name", spec.Name, "zone", zone)
+	record, err := s.recordsets.Get(ctx, project, zone, spec.Name, spec.Type)
+	if err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for record set", "name", spec.Name)
+			return err
+		}
+
+		log.V(2).Info("Creating a record set", "name", spec.Name, "zone", zone)
+		if err := s.recordsets.Change(ctx, project, zone, &dns.Change{Additions: []*dns.ResourceRecordSet{spec}}); err != nil {
+			log.Error(err, "Error creating a record set", "name", spec.Name)
+			return err
+		}
+
+		return nil
+	}
+
+	// A re-created load balancer gets a new address.
+	if reflect.DeepEqual(record.Rrdatas, spec.Rrdatas) {
+		return nil
+	}
+
+	log.V(2).Info("Updating record set", "name", spec.Name, "zone", zone, "rrdatas", spec.Rrdatas)
+	if err := s.recordsets.Change(ctx, project, zone, &dns.Change{
+		Deletions: []*dns.ResourceRecordSet{record},
+		Additions: []*dns.ResourceRecordSet{spec},
+	}); err != nil {
+		log.Error(err, "Error updating record set", "name", spec.Name)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) deleteRecordSet(ctx context.Context, spec *dns.ResourceRecordSet) error {
+	log := log.FromContext(ctx)
+	project, zone := s.scope.Project(), s.scope.ManagedZoneName()
+	log.V(2).Info("Looking for record set", "name", spec.Name, "type", spec.Type, "zone", zone)
+	record, err := s.recordsets.Get(ctx, project, zone, spec.Name, spec.Type)
+	switch {
+	case gcperrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Error(err, "Error looking for record set", "name", spec.Name)
+		return err
+	}
+
+	log.V(2).Info("Deleting record set", "name", spec.Name, "type", spec.Type, "zone", zone)
+	if err := s.recordsets.Change(ctx, project, zone, &dns.Change{Deletions: []*dns.ResourceRecordSet{record}}); err != nil {
+		log.Error(err, "Error deleting record set", "name", spec.Name)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+type fakeManagedZones struct {
+	zones map[string]*dns.ManagedZone
+}
+
+func (f *fakeManagedZones) Get(_ context.Context, _, name string) (*dns.ManagedZone, error) {
+	if z, ok := f.zones[name]; ok {
+		return z, nil
+	}
+
+	return nil, &googleapi.Error{Code: http.StatusNotFound}
+}
+
+func (f *fakeManagedZones) Create(_ context.Context, _ string, zone *dns.ManagedZone) error {
+	f.zones[zone.Name] = zone
+	return nil
+}
+
+func (f *fakeManagedZones) Delete(_ context.Context, _, name string) error {
+	if _, ok := f.zones[name]; !ok {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	delete(f.zones, name)
+	return nil
+}
+
+type fakeRecordSets struct {
+	records map[string]*dns.ResourceRecordSet
+	changes int
+}
+
+func (f *fakeRecordSets) Get(_ context.Context, _, zone, name, recordType string) (*dns.ResourceRecordSet, error) {
+	if r, ok := f.records[zone+"/"+name+"/"+recordType]; ok {
+		return r, nil
+	}
+
+	return nil, &googleapi.Error{Code: http.StatusNotFound}
+}
+
+func (f *fakeRecordSets) Change(_ context.Context, _, zone string, change *dns.Change) error {
+	f.changes++
+	for _, r := range change.Deletions {
+		delete(f.records, zone+"/"+r.Name+"/"+r.Type)
+	}
+	for _, r := range change.Additions {
+		f.records[zone+"/"+r.Name+"/"+r.Type] = r
+	}
+
+	return nil
+}
+
+func newClusterScope(t *testing.T, spec *infrav1.DNSSpec) *scope.ClusterScope {
+	t.Helper()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: clusterv1.ClusterSpec{
+				ClusterNetwork: &clusterv1.ClusterNetwork{},
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				Project: "my-proj",
+				Region:  "us-central1",
+				DNS:     spec,
+			},
+			Status: infrav1.GCPClusterStatus{
+				Network: infrav1.Network{
+					SelfLink:    pointer.String("https://www.googleapis.com/compute/v1/projects/my-proj/global/networks/my-cluster"),
+					APIServerIP: pointer.String("203.0.113.10"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return clusterScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           *infrav1.DNSSpec
+		zones          map[string]*dns.ManagedZone
+		wantErr        bool
+		wantZone       string
+		wantVisibility string
+		wantHost       string
+	}{
+		{
+			name:           "creates a public zone",
+			spec:           &infrav1.DNSSpec{Domain: "k8s.example.com"},
+			zones:          map[string]*dns.ManagedZone{},
+			wantZone:       "my-cluster",
+			wantVisibility: "public",
+			wantHost:       "api.my-cluster.k8s.example.com",
+		},
+		{
+			name: "creates a private zone with a custom record name",
+			spec: &infrav1.DNSSpec{
+				Domain:              "k8s.example.com.",
+				Visibility:          infrav1.DNSZoneVisibilityPrivate,
+				APIServerRecordName: pointer.String("control-plane"),
+			},
+			zones:          map[string]*dns.ManagedZone{},
+			wantZone:       "my-cluster",
+			wantVisibility: "private",
+			wantHost:       "control-plane.k8s.example.com",
+		},
+		{
+			name: "uses an existing zone",
+			spec: &infrav1.DNSSpec{Domain: "example.com", ManagedZone: pointer.String("shared")},
+			zones: map[string]*dns.ManagedZone{
+				"shared": {Name: "shared", DnsName: "example.com.", Visibility: "public"},
+			},
+			wantZone:       "shared",
+			wantVisibility: "public",
+			wantHost:       "api.my-cluster.example.com",
+		},
+		{
+			name:    "existing zone not found",
+			spec:    &infrav1.DNSSpec{Domain: "example.com", ManagedZone: pointer.String("shared")},
+			zones:   map[string]*dns.ManagedZone{},
+			wantErr: true,
+		},
+		{
+			name: "existing zone serving another domain",
+			spec: &infrav1.DNSSpec{Domain: "example.com", ManagedZone: pointer.String("shared")},
+			zones: map[string]*dns.ManagedZone{
+				"shared": {Name: "shared", DnsName: "example.org."},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterScope := newClusterScope(t, tt.spec)
+			zones := &fakeManagedZones{zones: tt.zones}
+			records := &fakeRecordSets{records: map[string]*dns.ResourceRecordSet{}}
+			s := &Service{scope: clusterScope, managedzones: zones, recordsets: records}
+
+			err := s.Reconcile(context.TODO())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			zone, ok := zones.zones[tt.wantZone]
+			if !ok {
+				t.Fatalf("Service.Reconcile() did not create the managed zone %q", tt.wantZone)
+			}
+			if zone.Visibility != tt.wantVisibility {
+				t.Errorf("Service.Reconcile() zone visibility = %q, want %q", zone.Visibility, tt.wantVisibility)
+			}
+
+			record, ok := records.records[tt.wantZone+"/"+tt.wantHost+"./A"]
+			if !ok {
+				t.Fatalf("Service.Reconcile() did not create the record set %q", tt.wantHost)
+			}
+			if want := []string{"203.0.113.10"}; !reflect.DeepEqual(record.Rrdatas, want) {
+				t.Errorf("Service.Reconcile() rrdatas = %v, want %v", record.Rrdatas, want)
+			}
+
+			if got := clusterScope.ControlPlaneEndpoint().Host; got != tt.wantHost {
+				t.Errorf("Service.Reconcile() endpoint host = %q, want %q", got, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_UpdatesAddress(t *testing.T) {
+	clusterScope := newClusterScope(t, &infrav1.DNSSpec{Domain: "k8s.example.com"})
+	zones := &fakeManagedZones{zones: map[string]*dns.ManagedZone{}}
+	records := &fakeRecordSets{records: map[string]*dns.ResourceRecordSet{}}
+	s := &Service{scope: clusterScope, managedzones: zones, recordsets: records}
+
+	if err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+	if err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+	if records.changes != 1 {
+		t.Errorf("Service.Reconcile() changes = %d, want 1", records.changes)
+	}
+
+	clusterScope.Network().APIServerIP = pointer.String("203.0.113.20")
+	if err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	record := records.records["my-cluster/api.my-cluster.k8s.example.com./A"]
+	if want := []string{"203.0.113.20"}; !reflect.DeepEqual(record.Rrdatas, want) {
+		t.Errorf("Service.Reconcile() rrdatas = %v, want %v", record.Rrdatas, want)
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      *infrav1.DNSSpec
+		wantZones []string
+	}{
+		{
+			name:      "deletes the owned zone",
+			spec:      &infrav1.DNSSpec{Domain: "example.com"},
+			wantZones: []string{"shared"},
+		},
+		{
+			name:      "keeps the existing zone",
+			spec:      &infrav1.DNSSpec{Domain: "example.com", ManagedZone: pointer.String("shared")},
+			wantZones: []string{"shared"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterScope := newClusterScope(t, tt.spec)
+			zones := &fakeManagedZones{zones: map[string]*dns.ManagedZone{
+				"shared":     {Name: "shared", DnsName: "example.com."},
+				"my-cluster": {Name: "my-cluster", DnsName: "example.com."},
+			}}
+			if tt.spec.ManagedZone != nil {
+				delete(zones.zones, "my-cluster")
+			}
+			records := &fakeRecordSets{records: map[string]*dns.ResourceRecordSet{}}
+			s := &Service{scope: clusterScope, managedzones: zones, recordsets: records}
+
+			if err := s.Reconcile(context.TODO()); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+			if err := s.Delete(context.TODO()); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+
+			if len(records.records) != 0 {
+				t.Errorf("Service.Delete() records = %v, want none", records.records)
+			}
+
+			var got []string
+			for name := range zones.zones {
+				got = append(got, name)
+			}
+			if !reflect.DeepEqual(got, tt.wantZones) {
+				t.Errorf("Service.Delete() zones = %v, want %v", got, tt.wantZones)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_DualStack(t *testing.T) {
+	dualStack := infrav1.StackTypeIPv4IPv6
+	clusterScope := newClusterScope(t, &infrav1.DNSSpec{Domain: "k8s.example.com"})
+	clusterScope.GCPCluster.Spec.Network.APIServerStackType = &dualStack
+	zones := &fakeManagedZones{zones: map[string]*dns.ManagedZone{}}
+	records := &fakeRecordSets{records: map[string]*dns.ResourceRecordSet{}}
+	s := &Service{scope: clusterScope, managedzones: zones, recordsets: records}
+
+	if err := s.Reconcile(context.TODO()); err == nil {
+		t.Fatalf("Service.Reconcile() error = nil, want an error until the IPv6 address is reserved")
+	}
+
+	clusterScope.Network().APIServerIPv6 = pointer.String("2001:db8::10")
+	if err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatalf("Service.Reconcile() error = %v", err)
+	}
+
+	for recordType, want := range map[string][]string{"A": {"203.0.113.10"}, "AAAA": {"2001:db8::10"}} {
+		record, ok := records.records["my-cluster/api.my-cluster.k8s.example.com./"+recordType]
+		if !ok {
+			t.Fatalf("Service.Reconcile() did not create the %s record set", recordType)
+		}
+		if !reflect.DeepEqual(record.Rrdatas, want) {
+			t.Errorf("Service.Reconcile() %s rrdatas = %v, want %v", recordType, record.Rrdatas, want)
+		}
+	}
+
+	if err := s.Delete(context.TODO()); err != nil {
+		t.Fatalf("Service.Delete() error = %v", err)
+	}
+	if len(records.records) != 0 {
+		t.Errorf("Service.Delete() records = %v, want none", records.records)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type managedzonesInterface interface {
+	Get(ctx context.Context, project, name string) (*dns.ManagedZone, error)
+	Create(ctx context.Context, project string, zone *dns.ManagedZone) error
+	Delete(ctx context.Context, project, name string) error
+}
+
+type recordsetsInterface interface {
+	Get(ctx context.Context, project, zone, name, recordType string) (*dns.ResourceRecordSet, error)
+	Change(ctx context.Context, project, zone string, change *dns.Change) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Cluster
+	DNS() *infrav1.DNSSpec
+	APIServerDNSName() string
+	ManagedZoneName() string
+	ManagedZoneSpec() *dns.ManagedZone
+	APIServerRecordSetSpecs() []*dns.ResourceRecordSet
+	RateLimiter() cloud.RateLimiter
+}
+
+// Service implements control plane endpoint DNS reconciler.
+type Service struct {
+	scope        Scope
+	managedzones managedzonesInterface
+	recordsets   recordsetsInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:        scope,
+		managedzones: &managedzones{svc: scope.DNSClient(), rl: scope.RateLimiter()},
+		recordsets:   &recordsets{svc: scope.DNSClient(), rl: scope.RateLimiter()},
+	}
+}
+
+// accept blocks until the given operation of the cloud dns service can be performed.
+func accept(ctx context.Context, rl cloud.RateLimiter, project, service, operation string) error {
+	return rl.Accept(ctx, &cloud.RateLimitKey{
+		ProjectID: project,
+		Operation: operation,
+		Version:   "v1",
+		Service:   service,
+	})
+}
+
+// managedzones adapts the cloud dns client to managedzonesInterface.
+type managedzones struct {
+	svc *dns.Service
+	rl  cloud.RateLimiter
+}
+
+func (z *managedzones) Get(ctx context.Context, project, name string) (*dns.ManagedZone, error) {
+	if err := accept(ctx, z.rl, project, "ManagedZones", "Get"); err != nil {
+		return nil, err
+	}
+
+	return z.svc.ManagedZones.Get(project, name).Context(ctx).Do()
+}
+
+func (z *managedzones) Create(ctx context.Context, project string, zone *dns.ManagedZone) error {
+	if err := accept(ctx, z.rl, project, "ManagedZones", "Create"); err != nil {
+		return err
+	}
+
+	_, err := z.svc.ManagedZones.Create(project, zone).Context(ctx).Do()
+	return err
+}
+
+func (z *managedzones) Delete(ctx context.Context, project, name string) error {
+	if err := accept(ctx, z.rl, project, "ManagedZones", "Delete"); err != nil {
+		return err
+	}
+
+	return z.svc.ManagedZones.Delete(project, name).Context(ctx).Do()
+}
+
+// recordsets adapts the cloud dns client to recordsetsInterface.
+type recordsets struct {
+	svc *dns.Service
+	rl  cloud.RateLimiter
+}
+
+// Get returns the record set of the given name and type, or a not found error.
+func (r *recordsets) Get(ctx context.Context, project, zone, name, recordType string) (*dns.ResourceRecordSet, error) {
+	if err := accept(ctx, r.rl, project, "ResourceRecordSets", "List"); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.svc.ResourceRecordSets.List(project, zone).Name(name).Type(recordType).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Rrsets) == 0 {
+		return nil, &googleapi.Error{Code: http.StatusNotFound, Message: "record set " + name + " not found"}
+	}
+
+	return resp.Rrsets[0], nil
+}
+
+func (r *recordsets) Change(ctx context.Context, project, zone string, change *dns.Change) error {
+	if err := accept(ctx, r.rl, project, "Changes", "Create"); err != nil {
+		return err
+	}
+
+	_, err := r.svc.Changes.Create(project, zone, change).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/secretmanager/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+const (
+	// secretAccessorRole is the role allowing to access the payload of the secret versions.
+	secretAccessorRole = "roles/secretmanager.secretAccessor"
+	// maxPayloadSize is the maximum size of a secret version payload.
+	maxPayloadSize = 64 * 1024
+)
+
+// Reconcile reconcile machine bootstrap data secret.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if s.scope.BootstrapDataStorage() != infrav1.BootstrapDataStorageSecretManager {
+		return nil
+	}
+
+	// The bootstrap data is not needed anymore once the instance joined the cluster.
+	if s.scope.HasNodeRef() {
+		if s.scope.GetBootstrapDataSecret() == nil {
+			return nil
+		}
+
+		return s.Delete(ctx)
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Reconciling bootstrap data secret resources")
+	secretName := s.secretName()
+	log.V(2).Info("Looking for secret", "name", secretName)
+	if _, err := s.secrets.Get(ctx, secretName); err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for secret", "name", secretName)
+			return err
+		}
+
+		log.V(2).Info("Creating a secret", "name", secretName)
+		if err := s.secrets.Create(ctx, path.Join("projects", s.scope.Project()), s.scope.BootstrapDataSecretName(), s.secretSpec()); err != nil {
+			log.Error(err, "Error creating a secret", "name", secretName)
+			return err
+		}
+	}
+
+	versions, err := s.secrets.ListVersions(ctx, secretName)
+	if err != nil {
+		log.Error(err, "Error listing secret versions", "name", secretName)
+		return err
+	}
+
+	if !hasEnabledVersion(versions) {
+		data, err := s.secretData()
+		if err != nil {
+			return err
+		}
+
+		log.V(2).Info("Adding a secret version", "name", secretName)
+		if err := s.secrets.AddVersion(ctx, secretName, data); err != nil {
+			log.Error(err, "Error adding a secret version", "name", secretName)
+			return err
+		}
+	}
+
+	email, err := s.scope.InstanceServiceAccountEmail(ctx)
+	if err != nil {
+		return err
+	}
+
+	member := "serviceAccount:" + email
+
+	log.V(2).Info("Granting secret access to the instance service account", "name", secretName, "member", member)
+	if err := s.secrets.SetIamPolicy(ctx, secretName, &secretmanager.Policy{
+		Bindings: []*secretmanager.Binding{
+			{
+				Role:    secretAccessorRole,
+				Members: []string{member},
+			},
+		},
+	}); err != nil {
+		log.Error(err, "Error setting secret iam policy", "name", secretName)
+		return err
+	}
+
+	s.scope.SetBootstrapDataSecret(pointer.StringPtr(secretName))
+	return nil
+}
+
+// Delete delete machine bootstrap data secret.
+func (s *Service) Delete(ctx context.Context) error {
+	if s.scope.BootstrapDataStorage() != infrav1.BootstrapDataStorageSecretManager {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Deleting bootstrap data secret resources")
+	secretName := s.secretName()
+	log.V(2).Info("Deleting secret", "name", secretName)
+	if err := gcperrors.IgnoreNotFound(s.secrets.Delete(ctx, secretName)); err != nil {
+		log.Error(err, "Error deleting secret", "name", secretName)
+		return err
+	}
+
+	s.scope.SetBootstrapDataSecret(nil)
+	return nil
+}
+
+func (s *Service) secretName() string {
+	return path.Join("projects", s.scope.Project(), "secrets", s.scope.BootstrapDataSecretName())
+}
+
+func (s *Service) secretSpec() *secretmanager.Secret {
+	return &secretmanager.Secret{
+		Labels: infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.ResourceNamePrefix(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Role:        pointer.StringPtr(s.scope.Role()),
+		}),
+		Replication: &secretmanager.Replication{
+			Automatic: &secretmanager.Automatic{},
+		},
+	}
+}
+
+// secretData returns the gzipped bootstrap data, to fit within the secret payload size limit.
+func (s *Service) secretData() ([]byte, error) {
+	bootstrapData, format, err := s.scope.GetBootstrapDataWithFormat()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve bootstrap data")
+	}
+
+	if format == cloud.BootstrapDataFormatIgnition {
+		return nil, errors.New("ignition bootstrap data can't be stored in Secret Manager, use GCS instead")
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(bootstrapData)); err != nil {
+		return nil, errors.Wrap(err, "failed to compress bootstrap data")
+	}
+	if err := gz.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to compress bootstrap data")
+	}
+
+	if buf.Len() > maxPayloadSize {
+		return nil, errors.Errorf("compressed bootstrap data is %d bytes, exceeding the secret payload limit of %d bytes", buf.Len(), maxPayloadSize)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func hasEnabledVersion(versions []*secretmanager.SecretVersion) bool {
+	for _, v := range versions {
+		if v.State == "ENABLED" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/secretmanager/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+const secretName = "projects/my-proj/secrets/my-cluster-my-machine-bootstrap"
+
+type fakeSecrets struct {
+	secrets  map[string]*secretmanager.Secret
+	versions map[string][][]byte
+	policies map[string]*secretmanager.Policy
+	deletes  int
+}
+
+func newFakeSecrets() *fakeSecrets {
+	return &fakeSecrets{
+		secrets:  map[string]*secretmanager.Secret{},
+		versions: map[string][][]byte{},
+		policies: map[string]*secretmanager.Policy{},
+	}
+}
+
+func (f *fakeSecrets) Get(_ context.Context, name string) (*secretmanager.Secret, error) {
+	secret, ok := f.secrets[name]
+	if !ok {
+		return nil, &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	return secret, nil
+}
+
+func (f *fakeSecrets) Create(_ context.Context, parent, secretID string, secret *secretmanager.Secret) error {
+	f.secrets[parent+"/secrets/"+secretID] = secret
+	return nil
+}
+
+func (f *fakeSecrets) Delete(_ context.Context, name string) error {
+	f.deletes++
+	if _, ok := f.secrets[name]; !ok {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	delete(f.secrets, name)
+	delete(f.versions, name)
+	delete(f.policies, name)
+	return nil
+}
+
+func (f *fakeSecrets) AddVersion(_ context.Context, name string, data []byte) error {
+	f.versions[name] = append(f.versions[name], data)
+	return nil
+}
+
+func (f *fakeSecrets) ListVersions(_ context.Context, name string) ([]*secretmanager.SecretVersion, error) {
+	versions := make([]*secretmanager.SecretVersion, 0, len(f.versions[name]))
+	for range f.versions[name] {
+		versions = append(versions, &secretmanager.SecretVersion{State: "ENABLED"})
+	}
+
+	return versions, nil
+}
+
+func (f *fakeSecrets) SetIamPolicy(_ context.Context, name string, policy *secretmanager.Policy) error {
+	f.policies[name] = policy
+	return nil
+}
+
+func newMachineScope(t *testing.T, storage infrav1.BootstrapDataStorage, nodeRef *corev1.ObjectReference) *scope.MachineScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster-bootstrap",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"value": []byte("#cloud-config"),
+			},
+		}).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fakec,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client: fakec,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+			Spec: clusterv1.MachineSpec{
+				Bootstrap: clusterv1.Bootstrap{
+					DataSecretName: pointer.String("my-cluster-bootstrap"),
+				},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: nodeRef,
+			},
+		},
+		GCPMachine: &infrav1.GCPMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPMachineSpec{
+				BootstrapDataStorage: storage,
+				ServiceAccount: &infrav1.ServiceAccount{
+					Email:  "node@my-proj.iam.gserviceaccount.com",
+					Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+				},
+			},
+			Status: infrav1.GCPMachineStatus{
+				InstanceName: pointer.String("my-machine"),
+			},
+		},
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		storage     infrav1.BootstrapDataStorage
+		nodeRef     *corev1.ObjectReference
+		existing    bool
+		wantSecret  bool
+		wantStatus  *string
+		wantVersion string
+		wantDeletes int
+	}{
+		{
+			name:    "metadata storage (should not create a secret)",
+			storage: infrav1.BootstrapDataStorageMetadata,
+		},
+		{
+			name:        "secret manager storage (should create the secret)",
+			storage:     infrav1.BootstrapDataStorageSecretManager,
+			wantSecret:  true,
+			wantStatus:  pointer.String(secretName),
+			wantVersion: "#cloud-config",
+		},
+		{
+			name:        "machine has a node (should delete the secret)",
+			storage:     infrav1.BootstrapDataStorageSecretManager,
+			nodeRef:     &corev1.ObjectReference{Name: "my-node"},
+			existing:    true,
+			wantDeletes: 1,
+		},
+		{
+			name:    "machine has a node and the secret is deleted (should not delete the secret again)",
+			storage: infrav1.BootstrapDataStorageSecretManager,
+			nodeRef: &corev1.ObjectReference{Name: "my-node"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			machineScope := newMachineScope(t, tt.storage, tt.nodeRef)
+			fakeSecrets := newFakeSecrets()
+			if tt.existing {
+				fakeSecrets.secrets[secretName] = &secretmanager.Secret{Name: secretName}
+				fakeSecrets.versions[secretName] = [][]byte{[]byte("data")}
+				machineScope.SetBootstrapDataSecret(pointer.String(secretName))
+			}
+
+			s := &Service{
+				scope:   machineScope,
+				secrets: fakeSecrets,
+			}
+			if err := s.Reconcile(ctx); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			if fakeSecrets.deletes != tt.wantDeletes {
+				t.Errorf("Service.Reconcile() secret deletions = %d, want %d", fakeSecrets.deletes, tt.wantDeletes)
+			}
+
+			if _, ok := fakeSecrets.secrets[secretName]; ok != tt.wantSecret {
+				t.Errorf("Service.Reconcile() secret exists = %v, want %v", ok, tt.wantSecret)
+			}
+
+			if got := machineScope.GetBootstrapDataSecret(); pointer.StringDeref(got, "") != pointer.StringDeref(tt.wantStatus, "") {
+				t.Errorf("Service.Reconcile() status secret = %v, want %v", pointer.StringDeref(got, ""), pointer.StringDeref(tt.wantStatus, ""))
+			}
+
+			if !tt.wantSecret {
+				return
+			}
+
+			versions := fakeSecrets.versions[secretName]
+			if len(versions) != 1 {
+				t.Fatalf("Service.Reconcile() secret versions = %d, want 1", len(versions))
+			}
+
+			gz, err := gzip.NewReader(bytes.NewReader(versions[0]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := io.ReadAll(gz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.wantVersion {
+				t.Errorf("Service.Reconcile() secret data = %q, want %q", data, tt.wantVersion)
+			}
+
+			policy := fakeSecrets.policies[secretName]
+			if policy == nil || len(policy.Bindings) != 1 || policy.Bindings[0].Role != secretAccessorRole ||
+				len(policy.Bindings[0].Members) != 1 || policy.Bindings[0].Members[0] != "serviceAccount:node@my-proj.iam.gserviceaccount.com" {
+				t.Errorf("Service.Reconcile() secret iam policy = %+v, want accessor binding for the instance service account", policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+
+	"google.golang.org/api/secretmanager/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type secretsInterface interface {
+	Get(ctx context.Context, name string) (*secretmanager.Secret, error)
+	Create(ctx context.Context, parent, secretID string, secret *secretmanager.Secret) error
+	Delete(ctx context.Context, name string) error
+	AddVersion(ctx context.Context, name string, data []byte) error
+	ListVersions(ctx context.Context, name string) ([]*secretmanager.SecretVersion, error)
+	SetIamPolicy(ctx context.Context, name string, policy *secretmanager.Policy) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Machine
+	Project() string
+	ResourceNamePrefix() string
+	HasNodeRef() bool
+	BootstrapDataStorage() infrav1.BootstrapDataStorage
+	BootstrapDataSecretName() string
+	GetBootstrapDataSecret() *string
+	SetBootstrapDataSecret(v *string)
+	InstanceServiceAccountEmail(ctx context.Context) (string, error)
+}
+
+// Service implements bootstrap data secrets reconciler.
+type Service struct {
+	scope   Scope
+	secrets secretsInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:   scope,
+		secrets: &secrets{svc: scope.SecretManagerClient()},
+	}
+}
+
+// secrets adapts the secret manager client to secretsInterface.
+type secrets struct {
+	svc *secretmanager.Service
+}
+
+func (s *secrets) Get(ctx context.Context, name string) (*secretmanager.Secret, error) {
+	return s.svc.Projects.Secrets.Get(name).Context(ctx).Do()
+}
+
+func (s *secrets) Create(ctx context.Context, parent, secretID string, secret *secretmanager.Secret) error {
+	_, err := s.svc.Projects.Secrets.Create(parent, secret).SecretId(secretID).Context(ctx).Do()
+	return err
+}
+
+func (s *secrets) Delete(ctx context.Context, name string) error {
+	_, err := s.svc.Projects.Secrets.Delete(name).Context(ctx).Do()
+	return err
+}
+
+func (s *secrets) AddVersion(ctx context.Context, name string, data []byte) error {
+	_, err := s.svc.Projects.Secrets.AddVersion(name, &secretmanager.AddSecretVersionRequest{
+		Payload: &secretmanager.SecretPayload{
+			Data: base64.StdEncoding.EncodeToString(data),
+		},
+	}).Context(ctx).Do()
+	return err
+}
+
+func (s *secrets) ListVersions(ctx context.Context, name string) ([]*secretmanager.SecretVersion, error) {
+	var versions []*secretmanager.SecretVersion
+	err := s.svc.Projects.Secrets.Versions.List(name).Pages(ctx, func(resp *secretmanager.ListSecretVersionsResponse) error {
+		versions = append(versions, resp.Versions...)
+		return nil
+	})
+	return versions, err
+}
+
+func (s *secrets) SetIamPolicy(ctx context.Context, name string, policy *secretmanager.Policy) error {
+	_, err := s.svc.Projects.Secrets.SetIamPolicy(name, &secretmanager.SetIamPolicyRequest{
+		Policy: policy,
+	}).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/storage/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+)
+
+// Reconcile reconcile machine bootstrap data object.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if s.scope.BootstrapDataStorage() != infrav1.BootstrapDataStorageGCS {
+		return nil
+	}
+
+	// The bootstrap data is not needed anymore once the instance joined the cluster.
+	if s.scope.HasNodeRef() {
+		if s.scope.GetBootstrapDataObject() == nil {
+			return nil
+		}
+
+		return s.Delete(ctx)
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Reconciling bootstrap data object resources")
+	bucket := s.scope.BootstrapDataBucket()
+	objectName := s.scope.BootstrapDataObjectName()
+	log.V(2).Info("Looking for object", "bucket", bucket, "name", objectName)
+	if _, err := s.objects.Get(ctx, bucket, objectName); err != nil {
+		if !gcperrors.IsNotFound(err) {
+			log.Error(err, "Error looking for object", "bucket", bucket, "name", objectName)
+			return err
+		}
+
+		bootstrapData, err := s.scope.GetBootstrapData()
+		if err != nil {
+			return errors.Wrap(err, "failed to retrieve bootstrap data")
+		}
+
+		log.V(2).Info("Creating an object", "bucket", bucket, "name", objectName)
+		if err := s.objects.Insert(ctx, bucket, s.objectSpec(), []byte(bootstrapData)); err != nil {
+			log.Error(err, "Error creating an object", "bucket", bucket, "name", objectName)
+			return err
+		}
+	}
+
+	email, err := s.scope.InstanceServiceAccountEmail(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.V(2).Info("Granting object access to the instance service account", "bucket", bucket, "name", objectName, "email", email)
+	if err := s.objects.InsertAccessControl(ctx, bucket, objectName, &storage.ObjectAccessControl{
+		Entity: "user-" + email,
+		Role:   "READER",
+	}); err != nil {
+		log.Error(err, "Error setting object access control", "bucket", bucket, "name", objectName)
+		return err
+	}
+
+	s.scope.SetBootstrapDataObject(pointer.StringPtr(fmt.Sprintf("gs://%s/%s", bucket, objectName)))
+	return nil
+}
+
+// Delete delete machine bootstrap data object.
+func (s *Service) Delete(ctx context.Context) error {
+	if s.scope.BootstrapDataStorage() != infrav1.BootstrapDataStorageGCS {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	log.Info("Deleting bootstrap data object resources")
+	bucket := s.scope.BootstrapDataBucket()
+	objectName := s.scope.BootstrapDataObjectName()
+	log.V(2).Info("Deleting object", "bucket", bucket, "name", objectName)
+	if err := gcperrors.IgnoreNotFound(s.objects.Delete(ctx, bucket, objectName)); err != nil {
+		log.Error(err, "Error deleting object", "bucket", bucket, "name", objectName)
+		return err
+	}
+
+	s.scope.SetBootstrapDataObject(nil)
+	return nil
+}
+
+func (s *Service) objectSpec() *storage.Object {
+	return &storage.Object{
+		Name: s.scope.BootstrapDataObjectName(),
+		Metadata: infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.ResourceNamePrefix(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Role:        pointer.StringPtr(s.scope.Role()),
+		}),
+	}
+}
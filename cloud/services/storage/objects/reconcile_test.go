@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objects
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	_ = clusterv1.AddToScheme(scheme.Scheme)
+	_ = infrav1.AddToScheme(scheme.Scheme)
+}
+
+const (
+	bucket     = "my-bucket"
+	objectName = "my-cluster/my-machine-bootstrap"
+	objectURL  = "gs://my-bucket/my-cluster/my-machine-bootstrap"
+)
+
+type fakeObjects struct {
+	objects map[string][]byte
+	acls    map[string][]*storage.ObjectAccessControl
+	deletes int
+}
+
+func newFakeObjects() *fakeObjects {
+	return &fakeObjects{
+		objects: map[string][]byte{},
+		acls:    map[string][]*storage.ObjectAccessControl{},
+	}
+}
+
+func (f *fakeObjects) Get(_ context.Context, bucket, name string) (*storage.Object, error) {
+	if _, ok := f.objects[bucket+"/"+name]; !ok {
+		return nil, &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	return &storage.Object{Bucket: bucket, Name: name}, nil
+}
+
+func (f *fakeObjects) Insert(_ context.Context, bucket string, object *storage.Object, data []byte) error {
+	f.objects[bucket+"/"+object.Name] = data
+	return nil
+}
+
+func (f *fakeObjects) Delete(_ context.Context, bucket, name string) error {
+	f.deletes++
+	if _, ok := f.objects[bucket+"/"+name]; !ok {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+
+	delete(f.objects, bucket+"/"+name)
+	delete(f.acls, bucket+"/"+name)
+	return nil
+}
+
+func (f *fakeObjects) InsertAccessControl(_ context.Context, bucket, name string, acl *storage.ObjectAccessControl) error {
+	f.acls[bucket+"/"+name] = append(f.acls[bucket+"/"+name], acl)
+	return nil
+}
+
+func newMachineScope(t *testing.T, storage infrav1.BootstrapDataStorage, nodeRef *corev1.ObjectReference) *scope.MachineScope {
+	t.Helper()
+
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster-bootstrap",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"value": []byte("#cloud-config"),
+			},
+		}).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fakec,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPCluster: &infrav1.GCPCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPClusterSpec{
+				ResourceNamePrefix: pointer.String("my-cluster"),
+				Project:            "my-proj",
+				Region:             "us-central1",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client: fakec,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+			Spec: clusterv1.MachineSpec{
+				Bootstrap: clusterv1.Bootstrap{
+					DataSecretName: pointer.String("my-cluster-bootstrap"),
+				},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: nodeRef,
+			},
+		},
+		GCPMachine: &infrav1.GCPMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-machine",
+				Namespace: "default",
+			},
+			Spec: infrav1.GCPMachineSpec{
+				BootstrapDataStorage: storage,
+				BootstrapDataBucket:  pointer.String(bucket),
+				ServiceAccount: &infrav1.ServiceAccount{
+					Email:  "node@my-proj.iam.gserviceaccount.com",
+					Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+				},
+			},
+			Status: infrav1.GCPMachineStatus{
+				InstanceName: pointer.String("my-machine"),
+			},
+		},
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
+
+func TestService_Reconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		storage     infrav1.BootstrapDataStorage
+		nodeRef     *corev1.ObjectReference
+		existing    bool
+		wantObject  bool
+		wantStatus  *string
+		wantDeletes int
+	}{
+		{
+			name:    "metadata storage (should not create an object)",
+			storage: infrav1.BootstrapDataStorageMetadata,
+		},
+		{
+			name:       "gcs storage (should create the object)",
+			storage:    infrav1.BootstrapDataStorageGCS,
+			wantObject: true,
+			wantStatus: pointer.String(objectURL),
+		},
+		{
+			name:       "gcs storage with an existing object (should keep the object)",
+			storage:    infrav1.BootstrapDataStorageGCS,
+			existing:   true,
+			wantObject: true,
+			wantStatus: pointer.String(objectURL),
+		},
+		{
+			name:        "machine has a node (should delete the object)",
+			storage:     infrav1.BootstrapDataStorageGCS,
+			nodeRef:     &corev1.ObjectReference{Name: "my-node"},
+			existing:    true,
+			wantDeletes: 1,
+		},
+		{
+			name:    "machine has a node and the object is deleted (should not delete the object again)",
+			storage: infrav1.BootstrapDataStorageGCS,
+			nodeRef: &corev1.ObjectReference{Name: "my-node"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			machineScope := newMachineScope(t, tt.storage, tt.nodeRef)
+			fakeObjects := newFakeObjects()
+			if tt.existing {
+				fakeObjects.objects[bucket+"/"+objectName] = []byte("data")
+				machineScope.SetBootstrapDataObject(pointer.String(objectURL))
+			}
+
+			s := &Service{
+				scope:   machineScope,
+				objects: fakeObjects,
+			}
+			if err := s.Reconcile(ctx); err != nil {
+				t.Fatalf("Service.Reconcile() error = %v", err)
+			}
+
+			if fakeObjects.deletes != tt.wantDeletes {
+				t.Errorf("Service.Reconcile() object deletions = %d, want %d", fakeObjects.deletes, tt.wantDeletes)
+			}
+
+			data, ok := fakeObjects.objects[bucket+"/"+objectName]
+			if ok != tt.wantObject {
+				t.Errorf("Service.Reconcile() object exists = %v, want %v", ok, tt.wantObject)
+			}
+
+			if got := machineScope.GetBootstrapDataObject(); pointer.StringDeref(got, "") != pointer.StringDeref(tt.wantStatus, "") {
+				t.Errorf("Service.Reconcile() status object = %v, want %v", pointer.StringDeref(got, ""), pointer.StringDeref(tt.wantStatus, ""))
+			}
+
+			if !tt.wantObject {
+				return
+			}
+
+			if !tt.existing && string(data) != "#cloud-config" {
+				t.Errorf("Service.Reconcile() object data = %q, want %q", data, "#cloud-config")
+			}
+
+			acls := fakeObjects.acls[bucket+"/"+objectName]
+			if len(acls) != 1 || acls[0].Entity != "user-node@my-proj.iam.gserviceaccount.com" || acls[0].Role != "READER" {
+				t.Errorf("Service.Reconcile() object acls = %+v, want reader access for the instance service account", acls)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+	}{
+		{
+			name:     "object exists (should delete the object)",
+			existing: true,
+		},
+		{
+			name: "object not found (should succeed)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			machineScope := newMachineScope(t, infrav1.BootstrapDataStorageGCS, nil)
+			fakeObjects := newFakeObjects()
+			if tt.existing {
+				fakeObjects.objects[bucket+"/"+objectName] = []byte("data")
+			}
+			machineScope.SetBootstrapDataObject(pointer.String(objectURL))
+
+			s := &Service{
+				scope:   machineScope,
+				objects: fakeObjects,
+			}
+			if err := s.Delete(ctx); err != nil {
+				t.Fatalf("Service.Delete() error = %v", err)
+			}
+
+			if _, ok := fakeObjects.objects[bucket+"/"+objectName]; ok {
+				t.Errorf("Service.Delete() object still exists")
+			}
+
+			if got := machineScope.GetBootstrapDataObject(); got != nil {
+				t.Errorf("Service.Delete() status object = %v, want nil", *got)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objects
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/api/storage/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+)
+
+type objectsInterface interface {
+	Get(ctx context.Context, bucket, name string) (*storage.Object, error)
+	Insert(ctx context.Context, bucket string, object *storage.Object, data []byte) error
+	Delete(ctx context.Context, bucket, name string) error
+	InsertAccessControl(ctx context.Context, bucket, name string, acl *storage.ObjectAccessControl) error
+}
+
+// Scope is an interfaces that hold used methods.
+type Scope interface {
+	cloud.Machine
+	ResourceNamePrefix() string
+	HasNodeRef() bool
+	BootstrapDataStorage() infrav1.BootstrapDataStorage
+	BootstrapDataBucket() string
+	BootstrapDataObjectName() string
+	GetBootstrapDataObject() *string
+	SetBootstrapDataObject(v *string)
+	InstanceServiceAccountEmail(ctx context.Context) (string, error)
+}
+
+// Service implements bootstrap data objects reconciler.
+type Service struct {
+	scope   Scope
+	objects objectsInterface
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns Service from given scope.
+func New(scope Scope) *Service {
+	return &Service{
+		scope:   scope,
+		objects: &objects{svc: scope.StorageClient()},
+	}
+}
+
+// objects adapts the storage client to objectsInterface.
+type objects struct {
+	svc *storage.Service
+}
+
+func (o *objects) Get(ctx context.Context, bucket, name string) (*storage.Object, error) {
+	return o.svc.Objects.Get(bucket, name).Context(ctx).Do()
+}
+
+func (o *objects) Insert(ctx context.Context, bucket string, object *storage.Object, data []byte) error {
+	_, err := o.svc.Objects.Insert(bucket, object).PredefinedAcl("private").Media(bytes.NewReader(data)).Context(ctx).Do()
+	return err
+}
+
+func (o *objects) Delete(ctx context.Context, bucket, name string) error {
+	return o.svc.Objects.Delete(bucket, name).Context(ctx).Do()
+}
+
+func (o *objects) InsertAccessControl(ctx context.Context, bucket, name string, acl *storage.ObjectAccessControl) error {
+	_, err := o.svc.ObjectAccessControls.Insert(bucket, name, acl).Context(ctx).Do()
+	return err
+}
@@ -39,12 +39,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/bastions"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/firewalls"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/networks"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/resourcepolicies"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/securitypolicies"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/dns/records"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
 )
 
@@ -200,7 +204,11 @@ func (r *GCPClusterReconciler) reconcile(ctx context.Context, clusterScope *scop
 	reconcilers := []cloud.Reconciler{
 		networks.New(clusterScope),
 		firewalls.New(clusterScope),
+		bastions.New(clusterScope),
+		resourcepolicies.New(clusterScope),
+		securitypolicies.New(clusterScope),
 		loadbalancers.New(clusterScope),
+		records.New(clusterScope),
 	}
 
 	for _, r := range reconcilers {
@@ -229,7 +237,11 @@ func (r *GCPClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 	log.Info("Reconciling Delete GCPCluster")
 
 	reconcilers := []cloud.Reconciler{
+		records.New(clusterScope),
 		loadbalancers.New(clusterScope),
+		securitypolicies.New(clusterScope),
+		resourcepolicies.New(clusterScope),
+		bastions.New(clusterScope),
 		firewalls.New(clusterScope),
 		networks.New(clusterScope),
 	}
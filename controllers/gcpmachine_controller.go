@@ -38,9 +38,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/addresses"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/images"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/instances"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/secretmanager/secrets"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/storage/objects"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
 )
 
@@ -227,6 +231,30 @@ func (r *GCPMachineReconciler) reconcile(ctx context.Context, machineScope *scop
 		return ctrl.Result{}, err
 	}
 
+	if err := secrets.New(machineScope).Reconcile(ctx); err != nil {
+		log.Error(err, "Error reconciling bootstrap data secret resources")
+		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if err := objects.New(machineScope).Reconcile(ctx); err != nil {
+		log.Error(err, "Error reconciling bootstrap data object resources")
+		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if err := images.New(machineScope).Reconcile(ctx); err != nil {
+		log.Error(err, "Error reconciling image lookup")
+		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
+		return ctrl.Result{}, err
+	}
+
+	if err := addresses.New(machineScope).Reconcile(ctx); err != nil {
+		log.Error(err, "Error reconciling address resources")
+		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
+		return ctrl.Result{}, err
+	}
+
 	if err := instances.New(machineScope).Reconcile(ctx); err != nil {
 		log.Error(err, "Error reconciling instance resources")
 		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
@@ -261,6 +289,21 @@ func (r *GCPMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 		return ctrl.Result{}, err
 	}
 
+	if err := addresses.New(machineScope).Delete(ctx); err != nil {
+		log.Error(err, "Error deleting address resources")
+		return ctrl.Result{}, err
+	}
+
+	if err := secrets.New(machineScope).Delete(ctx); err != nil {
+		log.Error(err, "Error deleting bootstrap data secret resources")
+		return ctrl.Result{}, err
+	}
+
+	if err := objects.New(machineScope).Delete(ctx); err != nil {
+		log.Error(err, "Error deleting bootstrap data object resources")
+		return ctrl.Result{}, err
+	}
+
 	controllerutil.RemoveFinalizer(machineScope.GCPMachine, infrav1.MachineFinalizer)
 	record.Event(machineScope.GCPMachine, "GCPMachineReconcile", "Reconciled")
 	return ctrl.Result{}, nil
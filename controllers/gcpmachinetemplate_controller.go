@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/compute/machinetypes"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
+)
+
+// GCPMachineTemplateReconciler reconciles the capacity of a GCPMachineTemplate object, which is
+// used by the cluster autoscaler to scale node groups from zero.
+type GCPMachineTemplateReconciler struct {
+	client.Client
+	ReconcileTimeout time.Duration
+	WatchFilterValue string
+}
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinetemplates,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=gcpmachinetemplates/status,verbs=get;update;patch
+
+func (r *GCPMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.GCPMachineTemplate{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		Build(r)
+	if err != nil {
+		return errors.Wrap(err, "error creating controller")
+	}
+
+	// Add a watch on clusterv1.Cluster object for unpause & ready notifications, as the machine
+	// types are resolved in the failure domains of the cluster.
+	if err := c.Watch(
+		&source.Kind{Type: &clusterv1.Cluster{}},
+		handler.EnqueueRequestsFromMapFunc(r.ClusterToGCPMachineTemplates(ctx)),
+		predicates.ClusterUnpausedAndInfrastructureReady(log),
+	); err != nil {
+		return errors.Wrap(err, "failed adding a watch for ready clusters")
+	}
+
+	return nil
+}
+
+// ClusterToGCPMachineTemplates is a handler.ToRequestsFunc to be used to enqeue requests for reconciliation
+// of the GCPMachineTemplates of a cluster.
+func (r *GCPMachineTemplateReconciler) ClusterToGCPMachineTemplates(ctx context.Context) handler.MapFunc {
+	log := ctrl.LoggerFrom(ctx)
+	return func(o client.Object) []ctrl.Request {
+		c, ok := o.(*clusterv1.Cluster)
+		if !ok {
+			log.Error(errors.Errorf("expected a Cluster but got a %T", o), "failed to get GCPMachineTemplates for Cluster")
+			return nil
+		}
+
+		templates := &infrav1.GCPMachineTemplateList{}
+		if err := r.List(ctx, templates, client.InNamespace(c.Namespace)); err != nil {
+			log.Error(err, "failed to list GCPMachineTemplates")
+			return nil
+		}
+
+		result := []ctrl.Request{}
+		for i := range templates.Items {
+			template := &templates.Items[i]
+			if template.Labels[clusterv1.ClusterLabelName] != c.Name && !isOwnedByCluster(template, c.Name) {
+				continue
+			}
+			result = append(result, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(template)})
+		}
+
+		return result
+	}
+}
+
+func (r *GCPMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx, cancel := context.WithTimeout(ctx, reconciler.DefaultedLoopTimeout(r.ReconcileTimeout))
+	defer cancel()
+
+	log := ctrl.LoggerFrom(ctx)
+	gcpMachineTemplate := &infrav1.GCPMachineTemplate{}
+	err := r.Get(ctx, req.NamespacedName, gcpMachineTemplate)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	if !gcpMachineTemplate.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	cluster, err := r.getCluster(ctx, gcpMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("GCPMachineTemplate is not owned by a Cluster and is missing the cluster label")
+		return ctrl.Result{}, nil
+	}
+
+	if annotations.IsPaused(cluster, gcpMachineTemplate) {
+		log.Info("GCPMachineTemplate or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+	if !cluster.Status.InfrastructureReady {
+		log.Info("Cluster infrastructure is not ready yet")
+		return ctrl.Result{}, nil
+	}
+
+	gcpCluster := &infrav1.GCPCluster{}
+	gcpClusterKey := client.ObjectKey{
+		Namespace: gcpMachineTemplate.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, gcpClusterKey, gcpCluster); err != nil {
+		log.Info("GCPCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     r.Client,
+		Cluster:    cluster,
+		GCPCluster: gcpCluster,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine template scope
+	machineTemplateScope, err := scope.NewMachineTemplateScope(scope.MachineTemplateScopeParams{
+		Client:             r.Client,
+		ClusterGetter:      clusterScope,
+		GCPMachineTemplate: gcpMachineTemplate,
+	})
+	if err != nil {
+		return ctrl.Result{}, errors.Errorf("failed to create scope: %+v", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any GCPMachineTemplate changes.
+	defer func() {
+		if err := machineTemplateScope.Close(); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if err := machinetypes.New(machineTemplateScope).Reconcile(ctx); err != nil {
+		log.Error(err, "Error reconciling machine template capacity")
+		record.Warnf(gcpMachineTemplate, "GCPMachineTemplateReconcile", "Reconcile error - %v", err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getCluster returns the cluster of a GCPMachineTemplate: its owner, or the cluster named by its
+// cluster label. Templates are only owned by their cluster once a MachineSet references them.
+func (r *GCPMachineTemplateReconciler) getCluster(ctx context.Context, gcpMachineTemplate *infrav1.GCPMachineTemplate) (*clusterv1.Cluster, error) {
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, gcpMachineTemplate.ObjectMeta)
+	if err != nil || cluster != nil {
+		return cluster, err
+	}
+
+	if _, ok := gcpMachineTemplate.Labels[clusterv1.ClusterLabelName]; !ok {
+		return nil, nil
+	}
+
+	return util.GetClusterFromMetadata(ctx, r.Client, gcpMachineTemplate.ObjectMeta)
+}
+
+// isOwnedByCluster returns true if an object is owned by the named cluster.
+func isOwnedByCluster(obj client.Object, clusterName string) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+
+		if ref.Kind == "Cluster" && gv.Group == clusterv1.GroupVersion.Group && ref.Name == clusterName {
+			return true
+		}
+	}
+
+	return false
+}
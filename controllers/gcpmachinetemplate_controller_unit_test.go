@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+func newGCPMachineTemplate(name string, labels map[string]string, owners ...metav1.OwnerReference) *infrav1.GCPMachineTemplate {
+	return &infrav1.GCPMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			Labels:          labels,
+			OwnerReferences: owners,
+		},
+	}
+}
+
+func TestGCPMachineTemplateReconciler_ClusterToGCPMachineTemplates(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	clusterName := "my-cluster"
+	cluster := newCluster(clusterName)
+	cluster.UID = "my-cluster-uid"
+	initObjects := []runtime.Object{
+		cluster,
+		// Create a template owned by the cluster, one labeled with the cluster name and one of another cluster.
+		newGCPMachineTemplate("my-template-0", nil, metav1.OwnerReference{
+			Name:       clusterName,
+			Kind:       "Cluster",
+			APIVersion: clusterv1.GroupVersion.String(),
+			UID:        cluster.UID,
+		}),
+		newGCPMachineTemplate("my-template-1", map[string]string{clusterv1.ClusterLabelName: clusterName}),
+		newGCPMachineTemplate("my-template-2", map[string]string{clusterv1.ClusterLabelName: "my-other-cluster"}),
+	}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(initObjects...).Build()
+
+	reconciler := &GCPMachineTemplateReconciler{
+		Client: client,
+	}
+
+	fn := reconciler.ClusterToGCPMachineTemplates(context.Background())
+	rr := fn(cluster)
+	g.Expect(rr).To(HaveLen(2))
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,8 +31,9 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/klogr"
-	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha4"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/envtest/printer"
@@ -42,6 +48,7 @@ var (
 	cfg       *rest.Config
 	k8sClient client.Client
 	testEnv   *envtest.Environment
+	cancel    context.CancelFunc
 )
 
 func init() {
@@ -64,6 +71,11 @@ var _ = BeforeSuite(func(done Done) {
 		CRDDirectoryPaths: []string{
 			filepath.Join("..", "config", "crd", "bases"),
 		},
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{
+				filepath.Join("..", "config", "webhook"),
+			},
+		},
 	}
 
 	var err error
@@ -80,11 +92,46 @@ var _ = BeforeSuite(func(done Done) {
 	Expect(err).ToNot(HaveOccurred())
 	Expect(k8sClient).ToNot(BeNil())
 
+	By("starting the webhook server")
+	webhookOptions := &testEnv.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:             scheme.Scheme,
+		Host:               webhookOptions.LocalServingHost,
+		Port:               webhookOptions.LocalServingPort,
+		CertDir:            webhookOptions.LocalServingCertDir,
+		MetricsBindAddress: "0",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect((&infrav1.GCPCluster{}).SetupWebhookWithManager(mgr)).To(Succeed())
+	Expect((&infrav1.GCPMachine{}).SetupWebhookWithManager(mgr)).To(Succeed())
+	Expect((&infrav1.GCPMachineTemplate{}).SetupWebhookWithManager(mgr)).To(Succeed())
+	Expect((&infrav1.GCPClusterTemplate{}).SetupWebhookWithManager(mgr)).To(Succeed())
+
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(ctx)).To(Succeed())
+	}()
+
+	addr := net.JoinHostPort(webhookOptions.LocalServingHost, fmt.Sprint(webhookOptions.LocalServingPort))
+	Eventually(func() error {
+		conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, &tls.Config{InsecureSkipVerify: true}) // nolint:gosec
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}, 10*time.Second).Should(Succeed())
+
 	close(done)
 }, 60)
 
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
+	if cancel != nil {
+		cancel()
+	}
 	err := testEnv.Stop()
 	Expect(err).ToNot(HaveOccurred())
 })
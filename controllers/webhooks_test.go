@@ -0,0 +1,246 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+var _ = Describe("Defaulting webhooks", func() {
+	Context("Create a GCPCluster", func() {
+		It("should store the default network", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "default"},
+				Spec: infrav1.GCPClusterSpec{
+					Project: "my-proj",
+					Region:  "us-central1",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			Expect(instance.Spec.Network.Name).To(Equal(pointer.String("default")))
+			Expect(instance.Spec.Network.AutoCreateSubnetworks).To(Equal(pointer.Bool(true)))
+		})
+
+		It("should keep the network set in the spec", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom-network", Namespace: "default"},
+				Spec: infrav1.GCPClusterSpec{
+					Project: "my-proj",
+					Region:  "us-central1",
+					Network: infrav1.NetworkSpec{
+						Name:                  pointer.String("my-network"),
+						AutoCreateSubnetworks: pointer.Bool(false),
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			Expect(instance.Spec.Network.Name).To(Equal(pointer.String("my-network")))
+			Expect(instance.Spec.Network.AutoCreateSubnetworks).To(Equal(pointer.Bool(false)))
+		})
+	})
+
+	Context("Create a GCPMachine", func() {
+		It("should store the default root volume and service account", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "default"},
+				Spec: infrav1.GCPMachineSpec{
+					InstanceType: "n1-standard-2",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			pdStandard := infrav1.PdStandardDiskType
+			Expect(instance.Spec.RootDeviceSize).To(BeEquivalentTo(30))
+			Expect(instance.Spec.RootDeviceType).To(Equal(&pdStandard))
+			Expect(instance.Spec.ServiceAccount).To(Equal(&infrav1.ServiceAccount{
+				Email:  "default",
+				Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+			}))
+		})
+
+		It("should allow updates of the additional labels", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "updates", Namespace: "default"},
+				Spec: infrav1.GCPMachineSpec{
+					InstanceType: "n1-standard-2",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			instance.Spec.AdditionalLabels = infrav1.Labels{"team": "infra"}
+			Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("GCPMachineTemplate webhook", func() {
+	Context("Create a GCPMachineTemplate", func() {
+		It("should reject invalid machine specs", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "default"},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).NotTo(Succeed())
+		})
+	})
+
+	Context("Update a GCPMachineTemplate", func() {
+		It("should reject spec changes", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "immutable", Namespace: "default"},
+				Spec: infrav1.GCPMachineTemplateSpec{
+					Template: infrav1.GCPMachineTemplateResource{
+						Spec: infrav1.GCPMachineSpec{
+							InstanceType: "n1-standard-2",
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			instance.Spec.Template.Spec.InstanceType = "n1-standard-4"
+			Expect(k8sClient.Update(ctx, instance)).NotTo(Succeed())
+		})
+
+		It("should allow metadata changes", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "metadata", Namespace: "default"},
+				Spec: infrav1.GCPMachineTemplateSpec{
+					Template: infrav1.GCPMachineTemplateResource{
+						Spec: infrav1.GCPMachineSpec{
+							InstanceType: "n1-standard-2",
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			instance.Labels = map[string]string{"topology.cluster.x-k8s.io/owned": ""}
+			Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("GCPClusterTemplate webhook", func() {
+	Context("Create a GCPClusterTemplate", func() {
+		It("should store the default network", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "default"},
+				Spec: infrav1.GCPClusterTemplateSpec{
+					Template: infrav1.GCPClusterTemplateResource{
+						Spec: infrav1.GCPClusterSpec{
+							Project: "my-proj",
+							Region:  "us-central1",
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			Expect(instance.Spec.Template.Spec.Network.Name).To(Equal(pointer.String("default")))
+			Expect(instance.Spec.Template.Spec.Network.AutoCreateSubnetworks).To(Equal(pointer.Bool(true)))
+		})
+
+		It("should reject a resource name prefix", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "prefix", Namespace: "default"},
+				Spec: infrav1.GCPClusterTemplateSpec{
+					Template: infrav1.GCPClusterTemplateResource{
+						Spec: infrav1.GCPClusterSpec{
+							Project:            "my-proj",
+							Region:             "us-central1",
+							ResourceNamePrefix: pointer.String("shared"),
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).NotTo(Succeed())
+		})
+	})
+
+	Context("Update a GCPClusterTemplate", func() {
+		It("should reject spec changes", func() {
+			ctx := context.Background()
+			instance := &infrav1.GCPClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "immutable", Namespace: "default"},
+				Spec: infrav1.GCPClusterTemplateSpec{
+					Template: infrav1.GCPClusterTemplateResource{
+						Spec: infrav1.GCPClusterSpec{
+							Project: "my-proj",
+							Region:  "us-central1",
+						},
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+			}()
+
+			instance.Spec.Template.Spec.FailureDomains = []string{"us-central1-a"}
+			Expect(k8sClient.Update(ctx, instance)).NotTo(Succeed())
+		})
+	})
+})
@@ -42,6 +42,8 @@ import (
 
 	infrav1alpha3 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha3"
 	infrav1alpha4 "sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	infrav1beta1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/metrics"
 	"sigs.k8s.io/cluster-api-provider-gcp/controllers"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/reconciler"
 )
@@ -57,27 +59,29 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = infrav1alpha3.AddToScheme(scheme)
 	_ = infrav1alpha4.AddToScheme(scheme)
+	_ = infrav1beta1.AddToScheme(scheme)
 	_ = clusterv1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 var (
-	enableLeaderElection        bool
-	metricsAddr                 string
-	leaderElectionNamespace     string
-	watchNamespace              string
-	profilerAddress             string
-	healthAddr                  string
-	watchFilterValue            string
-	webhookCertDir              string
-	gcpClusterConcurrency       int
-	gcpMachineConcurrency       int
-	webhookPort                 int
-	reconcileTimeout            time.Duration
-	syncPeriod                  time.Duration
-	leaderElectionLeaseDuration time.Duration
-	leaderElectionRenewDeadline time.Duration
-	leaderElectionRetryPeriod   time.Duration
+	enableLeaderElection          bool
+	metricsAddr                   string
+	leaderElectionNamespace       string
+	watchNamespace                string
+	profilerAddress               string
+	healthAddr                    string
+	watchFilterValue              string
+	webhookCertDir                string
+	gcpClusterConcurrency         int
+	gcpMachineConcurrency         int
+	gcpMachineTemplateConcurrency int
+	webhookPort                   int
+	reconcileTimeout              time.Duration
+	syncPeriod                    time.Duration
+	leaderElectionLeaseDuration   time.Duration
+	leaderElectionRenewDeadline   time.Duration
+	leaderElectionRetryPeriod     time.Duration
 )
 
 func main() {
@@ -147,19 +151,36 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "GCPCluster")
 		os.Exit(1)
 	}
+	if err = (&controllers.GCPMachineTemplateReconciler{
+		Client:           mgr.GetClient(),
+		ReconcileTimeout: reconcileTimeout,
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: gcpMachineTemplateConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GCPMachineTemplate")
+		os.Exit(1)
+	}
 
-	if err = (&infrav1alpha4.GCPCluster{}).SetupWebhookWithManager(mgr); err != nil {
+	if err = (&infrav1beta1.GCPCluster{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "GCPCluster")
 		os.Exit(1)
 	}
-	if err = (&infrav1alpha4.GCPMachine{}).SetupWebhookWithManager(mgr); err != nil {
+	if err = (&infrav1beta1.GCPMachine{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "GCPMachine")
 		os.Exit(1)
 	}
-	if err = (&infrav1alpha4.GCPMachineTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+	if err = (&infrav1beta1.GCPMachineTemplate{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "GCPMachineTemplate")
 		os.Exit(1)
 	}
+	if err = (&infrav1beta1.GCPClusterTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "GCPClusterTemplate")
+		os.Exit(1)
+	}
+
+	if err := metrics.Register(mgr.GetClient()); err != nil {
+		setupLog.Error(err, "unable to register metrics")
+		os.Exit(1)
+	}
 
 	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to create ready check")
@@ -255,6 +276,12 @@ func initFlags(fs *pflag.FlagSet) {
 		"Number of GCPMachines to process simultaneously",
 	)
 
+	fs.IntVar(&gcpMachineTemplateConcurrency,
+		"gcpmachinetemplate-concurrency",
+		10,
+		"Number of GCPMachineTemplates to process simultaneously",
+	)
+
 	fs.DurationVar(&syncPeriod,
 		"sync-period",
 		10*time.Minute,
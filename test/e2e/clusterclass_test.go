@@ -0,0 +1,120 @@
+// +build e2e
+
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+// These specs check the contract of the infrastructure templates used by ClusterClasses:
+// templates are immutable, and topology patches are applied by creating new templates.
+var _ = Describe("Infrastructure templates", func() {
+	var (
+		ctx           = context.TODO()
+		specName      = "infrastructure-templates"
+		namespace     *corev1.Namespace
+		cancelWatches context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		Expect(bootstrapClusterProxy).ToNot(BeNil(), "Invalid argument. bootstrapClusterProxy can't be nil when calling %s spec", specName)
+		Expect(os.MkdirAll(artifactFolder, 0755)).To(Succeed(), "Invalid argument. artifactFolder can't be created for %s spec", specName)
+
+		// Setup a Namespace where to host objects for this spec and create a watcher for the namespace events.
+		namespace, cancelWatches = setupSpecNamespace(ctx, specName, bootstrapClusterProxy, artifactFolder)
+	})
+
+	AfterEach(func() {
+		cancelWatches()
+		Expect(bootstrapClusterProxy.GetClient().Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("Should create a GCPClusterTemplate and reject changes to its spec", func() {
+		c := bootstrapClusterProxy.GetClient()
+		template := &infrav1.GCPClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-template", Namespace: namespace.Name},
+			Spec: infrav1.GCPClusterTemplateSpec{
+				Template: infrav1.GCPClusterTemplateResource{
+					Spec: infrav1.GCPClusterSpec{
+						Project:        "my-project",
+						Region:         "us-central1",
+						FailureDomains: []string{"us-central1-a"},
+					},
+				},
+			},
+		}
+
+		By("Creating the template")
+		Expect(c.Create(ctx, template)).To(Succeed())
+		Expect(template.Spec.Template.Spec.Network.Name).To(Equal(pointer.String("default")))
+
+		By("Updating the failure domains of the template")
+		patched := template.DeepCopy()
+		patched.Spec.Template.Spec.FailureDomains = []string{"us-central1-b"}
+		Expect(c.Update(ctx, patched)).NotTo(Succeed())
+
+		By("Creating a new template with the patched failure domains")
+		rotated := &infrav1.GCPClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-template-rotated", Namespace: namespace.Name},
+			Spec:       *patched.Spec.DeepCopy(),
+		}
+		Expect(c.Create(ctx, rotated)).To(Succeed())
+	})
+
+	It("Should create a GCPMachineTemplate and reject changes to its spec", func() {
+		c := bootstrapClusterProxy.GetClient()
+		template := &infrav1.GCPMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-template", Namespace: namespace.Name},
+			Spec: infrav1.GCPMachineTemplateSpec{
+				Template: infrav1.GCPMachineTemplateResource{
+					Spec: infrav1.GCPMachineSpec{
+						InstanceType: "n1-standard-2",
+					},
+				},
+			},
+		}
+
+		By("Creating the template")
+		Expect(c.Create(ctx, template)).To(Succeed())
+		Expect(template.Spec.Template.Spec.RootDeviceSize).To(BeEquivalentTo(30))
+
+		By("Updating the instance type and failure domain of the template")
+		patched := template.DeepCopy()
+		patched.Spec.Template.Spec.InstanceType = "n1-standard-4"
+		patched.Spec.Template.Spec.FailureDomain = pointer.String("us-central1-b")
+		Expect(c.Update(ctx, patched)).NotTo(Succeed())
+
+		By("Creating a new template with the patched instance type and failure domain")
+		rotated := &infrav1.GCPMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-template-rotated", Namespace: namespace.Name},
+			Spec:       *patched.Spec.DeepCopy(),
+		}
+		Expect(c.Create(ctx, rotated)).To(Succeed())
+	})
+})
@@ -40,7 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api/test/framework/bootstrap"
 	"sigs.k8s.io/cluster-api/test/framework/clusterctl"
 
-	"sigs.k8s.io/cluster-api-provider-gcp/api/v1alpha4"
+	"sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 )
 
 const (
@@ -162,7 +162,7 @@ var _ = SynchronizedAfterSuite(func() {
 func initScheme() *runtime.Scheme {
 	sc := runtime.NewScheme()
 	framework.TryAddDefaultSchemes(sc)
-	Expect(v1alpha4.AddToScheme(sc)).To(Succeed())
+	Expect(v1beta1.AddToScheme(sc)).To(Succeed())
 
 	return sc
 }
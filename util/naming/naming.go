@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package naming implements the naming of the GCP resources of the clusters and the machines.
+package naming
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxLength is the maximum length of the name of a GCP resource.
+	MaxLength = 63
+	// MaxPrefixLength is the maximum length of the prefix of the names of the resources of a cluster,
+	// leaving room for the suffixes of the resource names.
+	MaxPrefixLength = 30
+
+	hashLength = 8
+)
+
+var (
+	nameRegexp    = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	invalidRegexp = regexp.MustCompile(`[^-a-z0-9]+`)
+)
+
+// Unique returns a name of at most maxLength characters for an object, made of its sanitized name
+// and of a hash of its namespace and name, so that objects with the same name in different namespaces
+// don't collide.
+func Unique(namespace, name string, maxLength int) string {
+	return withHash(sanitize(name), namespace+"/"+name, maxLength)
+}
+
+// Name returns the name of a resource joining parts with dashes, truncated deterministically
+// to MaxLength characters.
+func Name(parts ...string) string {
+	name := strings.Join(parts, "-")
+	if len(name) <= MaxLength {
+		return name
+	}
+
+	return withHash(name, name, MaxLength)
+}
+
+// Validate returns the reasons why name isn't a valid GCP resource name, which must be a RFC1035
+// label of at most maxLength characters.
+func Validate(name string, maxLength int) []string {
+	var errs []string
+	if len(name) > maxLength {
+		errs = append(errs, fmt.Sprintf("must be no more than %d characters", maxLength))
+	}
+
+	if !nameRegexp.MatchString(name) {
+		errs = append(errs, "must consist of lower case alphanumeric characters or '-', start with a letter and end with an alphanumeric character")
+	}
+
+	return errs
+}
+
+// sanitize turns name into a valid GCP resource name, without enforcing its length.
+func sanitize(name string) string {
+	name = strings.Trim(invalidRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "x" + name
+	}
+
+	return name
+}
+
+// withHash truncates name so that it fits maxLength characters once suffixed with a hash of key.
+func withHash(name, key string, maxLength int) string {
+	sum := sha256.Sum256([]byte(key))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+	if max := maxLength - hashLength - 1; len(name) > max {
+		name = strings.TrimRight(name[:max], "-")
+	}
+
+	return name + "-" + hash
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package naming_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/util/naming"
+)
+
+func TestUnique(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	prefix := naming.Unique("default", "my-cluster", naming.MaxPrefixLength)
+	g.Expect(prefix).To(gomega.HavePrefix("my-cluster-"))
+	g.Expect(naming.Validate(prefix, naming.MaxPrefixLength)).To(gomega.BeEmpty())
+	g.Expect(naming.Unique("default", "my-cluster", naming.MaxPrefixLength)).To(gomega.Equal(prefix))
+	g.Expect(naming.Unique("other", "my-cluster", naming.MaxPrefixLength)).NotTo(gomega.Equal(prefix))
+
+	for _, name := range []string{strings.Repeat("a", 253), "1.cluster", "My.Cluster", "-"} {
+		prefix := naming.Unique("default", name, naming.MaxPrefixLength)
+		g.Expect(naming.Validate(prefix, naming.MaxPrefixLength)).To(gomega.BeEmpty(), "prefix %q of %q", prefix, name)
+	}
+}
+
+func TestName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Parts    []string
+		Expected string
+	}{
+		{
+			Name:     "WithShortName",
+			Parts:    []string{"my-cluster", "apiserver"},
+			Expected: "my-cluster-apiserver",
+		},
+		{
+			Name:     "WithLongName",
+			Parts:    []string{strings.Repeat("a", 40), "apiserver", "northamerica-northeast1-a"},
+			Expected: strings.Repeat("a", 40) + "-apiserver-nor-",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			name := naming.Name(c.Parts...)
+			g.Expect(len(name)).To(gomega.BeNumerically("<=", naming.MaxLength))
+			g.Expect(name).To(gomega.HavePrefix(c.Expected))
+			g.Expect(naming.Validate(name, naming.MaxLength)).To(gomega.BeEmpty())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Subject string
+		Valid   bool
+	}{
+		{Name: "WithValidName", Subject: "my-cluster", Valid: true},
+		{Name: "WithLeadingDigit", Subject: "1cluster"},
+		{Name: "WithTrailingDash", Subject: "cluster-"},
+		{Name: "WithUpperCase", Subject: "Cluster"},
+		{Name: "WithTooLongName", Subject: strings.Repeat("a", naming.MaxPrefixLength+1)},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(naming.Validate(c.Subject, naming.MaxPrefixLength) == nil).To(gomega.Equal(c.Valid))
+		})
+	}
+}